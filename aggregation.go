@@ -0,0 +1,30 @@
+package beacon
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// IsAggregator returns true if the validator with the given selection proof
+// is an aggregator of a beacon committee of the given length.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/validator.md#aggregation-selection
+func (s *Spec) IsAggregator(committeeLength uint64, selectionProof phase0.BLSSignature) bool {
+	return isAggregator(committeeLength/s.TargetAggregatorsPerCommittee, selectionProof)
+}
+
+// IsSyncCommitteeAggregator returns true if the validator with the given
+// selection proof is an aggregator of its sync subcommittee.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/altair/validator.md#aggregation-selection
+func (s *Spec) IsSyncCommitteeAggregator(selectionProof phase0.BLSSignature) bool {
+	return isAggregator(s.SyncSubcommitteeSize()/s.TargetAggregatorsPerSyncSubcommittee, selectionProof)
+}
+
+func isAggregator(modulo uint64, selectionProof phase0.BLSSignature) bool {
+	if modulo < 1 {
+		modulo = 1
+	}
+	hash := sha256.Sum256(selectionProof[:])
+	return binary.LittleEndian.Uint64(hash[:8])%modulo == 0
+}
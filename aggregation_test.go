@@ -0,0 +1,46 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSpecIsAggregator compares against is_aggregator and is_sync_committee_aggregator
+// from the consensus specs, with selection proofs of bytes([i]) * 96 for i in range(16).
+func TestSpecIsAggregator(t *testing.T) {
+	tests := []struct {
+		committeeLength uint64
+		aggregators     []int
+	}{
+		{15, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}},
+		{128, []int{2, 12}},
+		{500, []int{1, 8}},
+	}
+	for _, test := range tests {
+		var aggregators []int
+		for i := 0; i < 16; i++ {
+			if Mainnet.IsAggregator(test.committeeLength, testSelectionProof(i)) {
+				aggregators = append(aggregators, i)
+			}
+		}
+		require.Equal(t, test.aggregators, aggregators, "committee length %d", test.committeeLength)
+	}
+
+	var aggregators []int
+	for i := 0; i < 16; i++ {
+		if Mainnet.IsSyncCommitteeAggregator(testSelectionProof(i)) {
+			aggregators = append(aggregators, i)
+		}
+	}
+	require.Equal(t, []int{2, 12}, aggregators)
+}
+
+func testSelectionProof(b int) phase0.BLSSignature {
+	var sig phase0.BLSSignature
+	for i := range sig {
+		sig[i] = byte(b)
+	}
+	return sig
+}
@@ -0,0 +1,98 @@
+package beacon
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// ErrInvalidCommitteeBits is returned when the committee bits of an
+// attestation don't identify exactly one valid committee.
+var ErrInvalidCommitteeBits = errors.New("invalid committee bits")
+
+// AttestationDataIndex returns the index to set in the AttestationData
+// of the given committee at the given slot. From Electra onwards, the
+// committee index is carried by committee_bits and the data index is 0.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/validator.md#construct-attestation
+func (s *Spec) AttestationDataIndex(slot phase0.Slot, committeeIndex phase0.CommitteeIndex) phase0.CommitteeIndex {
+	if s.IsForkActive(spec.DataVersionElectra, s.EpochFromSlot(slot)) {
+		return 0
+	}
+	return committeeIndex
+}
+
+// CommitteeBits returns the committee_bits of an attestation by the given committee.
+func (s *Spec) CommitteeBits(committeeIndex phase0.CommitteeIndex) (bitfield.Bitvector64, error) {
+	if uint64(committeeIndex) >= s.MaxCommitteesPerSlot {
+		return nil, fmt.Errorf("%w: committee index %d exceeds MaxCommitteesPerSlot (%d)",
+			ErrInvalidCommitteeBits, committeeIndex, s.MaxCommitteesPerSlot)
+	}
+	bits := bitfield.NewBitvector64()
+	bits.SetBitAt(uint64(committeeIndex), true)
+	return bits, nil
+}
+
+// CommitteeIndexFromBits returns the committee index of the given committee_bits,
+// which must have exactly one bit set within MaxCommitteesPerSlot.
+func (s *Spec) CommitteeIndexFromBits(bits bitfield.Bitvector64) (phase0.CommitteeIndex, error) {
+	if bits.Len() < s.MaxCommitteesPerSlot {
+		return 0, fmt.Errorf("%w: %d bits for MaxCommitteesPerSlot (%d)",
+			ErrInvalidCommitteeBits, bits.Len(), s.MaxCommitteesPerSlot)
+	}
+	indices := bits.BitIndices()
+	if len(indices) != 1 {
+		return 0, fmt.Errorf("%w: %d bits set", ErrInvalidCommitteeBits, len(indices))
+	}
+	if uint64(indices[0]) >= s.MaxCommitteesPerSlot {
+		return 0, fmt.Errorf("%w: committee index %d exceeds MaxCommitteesPerSlot (%d)",
+			ErrInvalidCommitteeBits, indices[0], s.MaxCommitteesPerSlot)
+	}
+	return phase0.CommitteeIndex(indices[0]), nil
+}
+
+// AttestationCommitteeIndex returns the committee index of the given attestation,
+// reading it from AttestationData before Electra and from committee_bits after.
+// It returns an error if the version of the attestation doesn't match the fork
+// which is active at its slot.
+func (s *Spec) AttestationCommitteeIndex(att *spec.VersionedAttestation) (phase0.CommitteeIndex, error) {
+	data, err := att.Data()
+	if err != nil {
+		return 0, err
+	}
+	electraActive := s.IsForkActive(spec.DataVersionElectra, s.EpochFromSlot(data.Slot))
+	if electraActive != (att.Version >= spec.DataVersionElectra) {
+		return 0, fmt.Errorf("%s attestation at slot %d of %s fork",
+			att.Version, data.Slot, s.DataVersionAtSlot(data.Slot))
+	}
+	if !electraActive {
+		return data.Index, nil
+	}
+	bits, err := att.CommitteeBits()
+	if err != nil {
+		return 0, err
+	}
+	return s.CommitteeIndexFromBits(bits)
+}
+
+// VersionedAttestationSubnetID returns the subnet ID for an attestation of any version.
+func (s *Spec) VersionedAttestationSubnetID(att *spec.VersionedAttestation, committeesAtSlot uint64) (SubnetID, error) {
+	committeeIndex, err := s.AttestationCommitteeIndex(att)
+	if err != nil {
+		return 0, err
+	}
+	data, err := att.Data()
+	if err != nil {
+		return 0, err
+	}
+	return s.AttestationSubnetID(data.Slot, committeeIndex, committeesAtSlot), nil
+}
+
+// SingleAttestationSubnetID returns the subnet ID for an Electra SingleAttestation.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/validator.md#broadcast-attestation
+func (s *Spec) SingleAttestationSubnetID(att *electra.SingleAttestation, committeesAtSlot uint64) SubnetID {
+	return s.AttestationSubnetID(att.Data.Slot, att.CommitteeIndex, committeesAtSlot)
+}
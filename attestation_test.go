@@ -0,0 +1,82 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecCommitteeBits(t *testing.T) {
+	bits, err := Mainnet.CommitteeBits(5)
+	require.NoError(t, err)
+	require.Equal(t, []int{5}, bits.BitIndices())
+
+	index, err := Mainnet.CommitteeIndexFromBits(bits)
+	require.NoError(t, err)
+	require.Equal(t, phase0.CommitteeIndex(5), index)
+
+	_, err = Mainnet.CommitteeBits(64)
+	require.ErrorIs(t, err, ErrInvalidCommitteeBits)
+
+	// Multiple or no committees are invalid.
+	bits.SetBitAt(6, true)
+	_, err = Mainnet.CommitteeIndexFromBits(bits)
+	require.ErrorIs(t, err, ErrInvalidCommitteeBits)
+	_, err = Mainnet.CommitteeIndexFromBits(bitfield.NewBitvector64())
+	require.ErrorIs(t, err, ErrInvalidCommitteeBits)
+
+	// Committees beyond MaxCommitteesPerSlot are invalid.
+	minimal := *Mainnet
+	minimal.MaxCommitteesPerSlot = 4
+	bits = bitfield.NewBitvector64()
+	bits.SetBitAt(4, true)
+	_, err = minimal.CommitteeIndexFromBits(bits)
+	require.ErrorIs(t, err, ErrInvalidCommitteeBits)
+}
+
+func TestSpecVersionedAttestationSubnetID(t *testing.T) {
+	const committeesAtSlot = 64
+	preElectraSlot := Mainnet.StartSlot(Mainnet.ElectraForkEpoch) - 1
+	electraSlot := Mainnet.StartSlot(Mainnet.ElectraForkEpoch) + 1
+
+	require.Equal(t, phase0.CommitteeIndex(7), Mainnet.AttestationDataIndex(preElectraSlot, 7))
+	require.Equal(t, phase0.CommitteeIndex(0), Mainnet.AttestationDataIndex(electraSlot, 7))
+
+	// Before Electra, the committee index is in the AttestationData.
+	deneb := &spec.VersionedAttestation{
+		Version: spec.DataVersionDeneb,
+		Deneb: &phase0.Attestation{
+			Data: &phase0.AttestationData{Slot: preElectraSlot, Index: 7},
+		},
+	}
+	subnet, err := Mainnet.VersionedAttestationSubnetID(deneb, committeesAtSlot)
+	require.NoError(t, err)
+	require.Equal(t, Mainnet.AttestationSubnetID(preElectraSlot, 7, committeesAtSlot), subnet)
+
+	// After Electra, the committee index is in the committee bits.
+	bits, err := Mainnet.CommitteeBits(7)
+	require.NoError(t, err)
+	data := &phase0.AttestationData{Slot: electraSlot, Index: 0}
+	electraAtt := &spec.VersionedAttestation{
+		Version: spec.DataVersionElectra,
+		Electra: &electra.Attestation{Data: data, CommitteeBits: bits},
+	}
+	subnet, err = Mainnet.VersionedAttestationSubnetID(electraAtt, committeesAtSlot)
+	require.NoError(t, err)
+	require.Equal(t, Mainnet.AttestationSubnetID(electraSlot, 7, committeesAtSlot), subnet)
+
+	single := &electra.SingleAttestation{CommitteeIndex: 7, Data: data}
+	require.Equal(t, subnet, Mainnet.SingleAttestationSubnetID(single, committeesAtSlot))
+
+	// Versions which don't match the fork schedule are rejected.
+	deneb.Deneb.Data.Slot = electraSlot
+	_, err = Mainnet.VersionedAttestationSubnetID(deneb, committeesAtSlot)
+	require.Error(t, err)
+	data.Slot = preElectraSlot
+	_, err = Mainnet.VersionedAttestationSubnetID(electraAtt, committeesAtSlot)
+	require.Error(t, err)
+}
@@ -0,0 +1,117 @@
+package beacon
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// safetyDecay is the maximum tolerated loss of the 1/3 safety margin
+// of FFG finality, in percents, for the weak subjectivity period.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/weak-subjectivity.md#constants
+const safetyDecay = 10
+
+// ActivationExitEpoch returns the epoch at which activations and exits
+// initiated in the given epoch take effect.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_activation_exit_epoch
+func (s *Spec) ActivationExitEpoch(epoch phase0.Epoch) phase0.Epoch {
+	return epoch + 1 + s.MaxSeedLookahead
+}
+
+// ValidatorChurnLimit returns the number of validators which can
+// exit per epoch before Electra.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_validator_churn_limit
+func (s *Spec) ValidatorChurnLimit(activeValidators uint64) uint64 {
+	return max(s.MinPerEpochChurnLimit, activeValidators/s.ChurnLimitQuotient)
+}
+
+// ActivationChurnLimit returns the number of validators which can be
+// activated per epoch before Electra, which is capped from Deneb onwards.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/beacon-chain.md#new-get_validator_activation_churn_limit
+func (s *Spec) ActivationChurnLimit(activeValidators uint64, epoch phase0.Epoch) uint64 {
+	churn := s.ValidatorChurnLimit(activeValidators)
+	if s.IsForkActive(spec.DataVersionDeneb, epoch) {
+		return min(s.MaxPerEpochActivationChurnLimit, churn)
+	}
+	return churn
+}
+
+// BalanceChurnLimit returns the balance which can churn per epoch from Electra onwards.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-get_balance_churn_limit
+func (s *Spec) BalanceChurnLimit(totalActiveBalance phase0.Gwei) phase0.Gwei {
+	churn := max(s.MinPerEpochChurnLimitElectra, totalActiveBalance/phase0.Gwei(s.ChurnLimitQuotient))
+	return churn - churn%s.EffectiveBalanceIncrement
+}
+
+// ActivationExitChurnLimit returns the balance which can be activated or
+// exited per epoch from Electra onwards.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-get_activation_exit_churn_limit
+func (s *Spec) ActivationExitChurnLimit(totalActiveBalance phase0.Gwei) phase0.Gwei {
+	return min(s.MaxPerEpochActivationExitChurnLimit, s.BalanceChurnLimit(totalActiveBalance))
+}
+
+// ConsolidationChurnLimit returns the balance which can be consolidated
+// per epoch from Electra onwards.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-get_consolidation_churn_limit
+func (s *Spec) ConsolidationChurnLimit(totalActiveBalance phase0.Gwei) phase0.Gwei {
+	return s.BalanceChurnLimit(totalActiveBalance) - s.ActivationExitChurnLimit(totalActiveBalance)
+}
+
+// WeakSubjectivityPeriod returns the weak subjectivity period, in epochs, of a state
+// at the given epoch with the given active validators and their total balance.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/weak-subjectivity.md#compute_weak_subjectivity_period
+// and https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/weak-subjectivity.md#modified-compute_weak_subjectivity_period
+func (s *Spec) WeakSubjectivityPeriod(activeValidators uint64, totalActiveBalance phase0.Gwei, epoch phase0.Epoch) phase0.Epoch {
+	period := s.MinValidatorWithdrawabilityDelay
+
+	if s.IsForkActive(spec.DataVersionElectra, epoch) {
+		delta := uint64(s.BalanceChurnLimit(totalActiveBalance))
+		return period + phase0.Epoch(safetyDecay*uint64(totalActiveBalance)/(2*delta*100))
+	}
+
+	if activeValidators == 0 {
+		return period
+	}
+	const ethToGwei = 1_000_000_000
+	var (
+		n          = activeValidators
+		t          = uint64(totalActiveBalance) / n / ethToGwei
+		maxBalance = uint64(s.MaxEffectiveBalance) / ethToGwei
+		delta      = s.ValidatorChurnLimit(n)
+		topUps     = s.MaxDeposits * uint64(s.SlotsPerEpoch)
+		d          = uint64(safetyDecay)
+	)
+	if maxBalance*(200+3*d) < t*(200+12*d) {
+		epochsForValidatorSetChurn := n * (t*(200+12*d) - maxBalance*(200+3*d)) / (600 * delta * (2*t + maxBalance))
+		epochsForBalanceTopUps := n * (200 + 3*d) / (600 * topUps)
+		return period + phase0.Epoch(max(epochsForValidatorSetChurn, epochsForBalanceTopUps))
+	}
+	return period + phase0.Epoch(3*n*d*t/(200*topUps*(maxBalance-t)))
+}
+
+// ExitQueueEpoch estimates the exit epoch of a validator which initiates its exit
+// at the given epoch, behind the given number of queued exits, before Electra.
+func (s *Spec) ExitQueueEpoch(epoch phase0.Epoch, activeValidators, queuedExits uint64) phase0.Epoch {
+	return s.ActivationExitEpoch(epoch) + phase0.Epoch(queuedExits/s.ValidatorChurnLimit(activeValidators))
+}
+
+// ExitQueueEpochElectra estimates the exit epoch of a validator with the given balance
+// which initiates its exit at the given epoch, behind the given queued exit balance,
+// from Electra onwards.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#new-compute_exit_epoch_and_update_churn
+func (s *Spec) ExitQueueEpochElectra(epoch phase0.Epoch, totalActiveBalance, queuedBalance, exitBalance phase0.Gwei) phase0.Epoch {
+	earliestExitEpoch := s.ActivationExitEpoch(epoch)
+	perEpochChurn := s.ActivationExitChurnLimit(totalActiveBalance)
+	balance := queuedBalance + exitBalance
+	if balance <= perEpochChurn {
+		return earliestExitEpoch
+	}
+	return earliestExitEpoch + phase0.Epoch((balance-perEpochChurn-1)/perEpochChurn+1)
+}
+
+// ActivationQueueEpoch estimates the activation epoch of a validator which becomes
+// eligible at the given epoch, behind the given number of queued activations,
+// before Electra.
+func (s *Spec) ActivationQueueEpoch(epoch phase0.Epoch, activeValidators, queuedActivations uint64) phase0.Epoch {
+	churn := s.ActivationChurnLimit(activeValidators, epoch)
+	return s.ActivationExitEpoch(epoch) + phase0.Epoch(queuedActivations/churn)
+}
@@ -0,0 +1,88 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+const ethToGwei = phase0.Gwei(1_000_000_000)
+
+func TestSpecChurnLimit(t *testing.T) {
+	require.Equal(t, uint64(4), Mainnet.ValidatorChurnLimit(100_000))
+	require.Equal(t, uint64(15), Mainnet.ValidatorChurnLimit(1_000_000))
+
+	// The activation churn is capped from Deneb onwards.
+	require.Equal(t, uint64(15), Mainnet.ActivationChurnLimit(1_000_000, Mainnet.DenebForkEpoch-1))
+	require.Equal(t, uint64(8), Mainnet.ActivationChurnLimit(1_000_000, Mainnet.DenebForkEpoch))
+
+	require.Equal(t, 128*ethToGwei, Mainnet.BalanceChurnLimit(1_000_000*ethToGwei))
+	require.Equal(t, 518*ethToGwei, Mainnet.BalanceChurnLimit(34_000_000*ethToGwei))
+	require.Equal(t, 256*ethToGwei, Mainnet.ActivationExitChurnLimit(34_000_000*ethToGwei))
+	require.Equal(t, 262*ethToGwei, Mainnet.ConsolidationChurnLimit(34_000_000*ethToGwei))
+	require.Equal(t, phase0.Gwei(0), Mainnet.ConsolidationChurnLimit(1_000_000*ethToGwei))
+}
+
+// TestSpecWeakSubjectivityPeriod reproduces the tables of the phase0
+// and Electra weak subjectivity specs.
+func TestSpecWeakSubjectivityPeriod(t *testing.T) {
+	tests := []struct {
+		averageBalance phase0.Gwei
+		validators     uint64
+		period         phase0.Epoch
+	}{
+		{28, 32768, 504},
+		{28, 65536, 752},
+		{28, 131072, 1248},
+		{28, 262144, 2241},
+		{28, 524288, 2241},
+		{28, 1048576, 2241},
+		{32, 32768, 665},
+		{32, 65536, 1075},
+		{32, 131072, 1894},
+		{32, 262144, 3532},
+		{32, 524288, 3532},
+		{32, 1048576, 3532},
+	}
+	for _, test := range tests {
+		totalBalance := test.averageBalance * ethToGwei * phase0.Gwei(test.validators)
+		period := Mainnet.WeakSubjectivityPeriod(test.validators, totalBalance, Mainnet.ElectraForkEpoch-1)
+		require.Equal(t, test.period, period, "%d validators of %d ETH", test.validators, test.averageBalance)
+	}
+
+	electraTests := []struct {
+		totalBalance phase0.Gwei
+		period       phase0.Epoch
+	}{
+		{1_048_576, 665},
+		{2_097_152, 1075},
+		{4_194_304, 1894},
+		{8_388_608, 3532},
+		{16_777_216, 3532},
+		{33_554_432, 3532},
+	}
+	for _, test := range electraTests {
+		period := Mainnet.WeakSubjectivityPeriod(0, test.totalBalance*ethToGwei, Mainnet.ElectraForkEpoch)
+		require.Equal(t, test.period, period, "%d ETH", test.totalBalance)
+	}
+}
+
+func TestSpecExitQueueEpoch(t *testing.T) {
+	const epoch = 400_000
+	require.Equal(t, phase0.Epoch(epoch+5), Mainnet.ActivationExitEpoch(epoch))
+
+	// 15 validators exit per epoch with 1M active validators.
+	require.Equal(t, phase0.Epoch(epoch+5), Mainnet.ExitQueueEpoch(epoch, 1_000_000, 14))
+	require.Equal(t, phase0.Epoch(epoch+5+10), Mainnet.ExitQueueEpoch(epoch, 1_000_000, 150))
+
+	// 8 validators are activated per epoch from Deneb onwards.
+	require.Equal(t, phase0.Epoch(epoch+5+10), Mainnet.ActivationQueueEpoch(epoch, 1_000_000, 80))
+
+	// 256 ETH exits per epoch with 34M ETH staked.
+	totalBalance := 34_000_000 * ethToGwei
+	require.Equal(t, phase0.Epoch(epoch+5), Mainnet.ExitQueueEpochElectra(epoch, totalBalance, 0, 32*ethToGwei))
+	require.Equal(t, phase0.Epoch(epoch+5), Mainnet.ExitQueueEpochElectra(epoch, totalBalance, 224*ethToGwei, 32*ethToGwei))
+	require.Equal(t, phase0.Epoch(epoch+6), Mainnet.ExitQueueEpochElectra(epoch, totalBalance, 225*ethToGwei, 32*ethToGwei))
+	require.Equal(t, phase0.Epoch(epoch+5+100), Mainnet.ExitQueueEpochElectra(epoch, totalBalance, 25_600*ethToGwei, 32*ethToGwei))
+}
@@ -8,6 +8,9 @@ import (
 )
 
 // Clock provides a clock for the Beacon Chain.
+//
+// Tickers started before genesis wait for genesis,
+// and tick from the genesis slot onwards.
 type Clock interface {
 	// Now returns the current Moment.
 	Now() Moment
@@ -18,92 +21,228 @@ type Clock interface {
 	// AtEpoch returns the Moment at the given epoch.
 	AtEpoch(phase0.Epoch) Moment
 
-	// AtTime returns the Moment at the given time.
+	// AtTime returns the Moment at the given time, or the Moment
+	// of the genesis slot if the given time is before genesis.
 	AtTime(time.Time) Moment
 
-	// EverySlot returns a channel that emits the current Moment at each slot.
+	// UntilGenesis returns the duration until genesis,
+	// or zero if genesis has passed.
+	UntilGenesis() time.Duration
+
+	// WaitForGenesis blocks until genesis or until the context is cancelled.
+	WaitForGenesis(context.Context) error
+
+	// AtSyncCommitteePeriod returns the Moment at the start of the given sync committee period.
+	AtSyncCommitteePeriod(uint64) Moment
+
+	// EverySlot returns a channel that emits the Moment of each slot.
+	// A consumer which falls behind receives the latest Moment once it's ready.
 	EverySlot(context.Context) <-chan Moment
 
-	// EveryEpoch returns a channel that emits the current Moment at each epoch.
+	// EveryEpoch returns a channel that emits the Moment of each epoch.
+	// A consumer which falls behind receives the latest Moment once it's ready.
 	EveryEpoch(context.Context) <-chan Moment
+
+	// EveryPeriod returns a channel that emits the Moment of each sync committee period.
+	// A consumer which falls behind receives the latest Moment once it's ready.
+	EveryPeriod(context.Context) <-chan Moment
+
+	// EveryOffset returns a channel that emits the Moment of each slot
+	// at the given offset into the slot.
+	EveryOffset(context.Context, time.Duration) <-chan Moment
+
+	// EveryInterval returns a channel that emits the Moment of each slot at the start
+	// of the given interval of the slot, which is divided into the given number
+	// of intervals. For example, attestations are due at EveryInterval(ctx, 1, 3).
+	// Zero intervals are the start of each slot, like EverySlot, and it panics
+	// if the interval isn't less than intervals (see SlotInterval).
+	EveryInterval(ctx context.Context, interval, intervals uint64) <-chan Moment
+
+	// Ticks returns a channel that emits a Tick at each scheduled Moment of the given
+	// Cadence, handling the ticks which the consumer isn't ready to receive
+	// according to the given TickPolicy.
+	Ticks(context.Context, Cadence, TickPolicy) <-chan Tick
 }
 
 // Params contains the required parameters for Clock.
 type Params struct {
-	GenesisTime   time.Time
+	GenesisTime time.Time
+
+	// GenesisSlot is the slot at GenesisTime. Slots and epochs are
+	// counted from zero regardless of it.
+	GenesisSlot phase0.Slot
+
 	SlotsPerEpoch phase0.Slot
 	SlotDuration  time.Duration
+
+	// EpochsPerSyncCommitteePeriod is used by the sync committee
+	// period methods of Clock and Moment.
+	// Defaults to DefaultEpochsPerSyncCommitteePeriod.
+	EpochsPerSyncCommitteePeriod phase0.Epoch
+
+	// AttestationPropagationSlotRange is used by
+	// Moment.AttestationPropagationDeadline.
+	// Defaults to DefaultAttestationPropagationSlotRange.
+	AttestationPropagationSlotRange phase0.Slot
+
+	// TimeSource provides the current time and timers.
+	// Defaults to SystemTime.
+	TimeSource TimeSource
 }
 
+const (
+	// DefaultEpochsPerSyncCommitteePeriod is the EpochsPerSyncCommitteePeriod
+	// of Params which don't set it, as on mainnet.
+	DefaultEpochsPerSyncCommitteePeriod phase0.Epoch = 256
+
+	// DefaultAttestationPropagationSlotRange is the AttestationPropagationSlotRange
+	// of Params which don't set it, as in the consensus specs.
+	DefaultAttestationPropagationSlotRange phase0.Slot = 32
+)
+
 type clock struct {
 	*Params
 }
 
 // New returns a new Clock.
 func New(params Params) Clock {
+	if params.TimeSource == nil {
+		params.TimeSource = SystemTime
+	}
+	if params.EpochsPerSyncCommitteePeriod == 0 {
+		params.EpochsPerSyncCommitteePeriod = DefaultEpochsPerSyncCommitteePeriod
+	}
+	if params.AttestationPropagationSlotRange == 0 {
+		params.AttestationPropagationSlotRange = DefaultAttestationPropagationSlotRange
+	}
 	return &clock{
 		Params: &params,
 	}
 }
 
 // Now returns the current Moment. It is a shorthand for
-// AtTime(time.Now())
-func (c clock) Now() Moment {
-	return c.AtTime(time.Now())
+// AtTime(TimeSource.Now())
+func (c *clock) Now() Moment {
+	return c.AtTime(c.TimeSource.Now())
 }
 
 // AtSlot returns the Moment at the given slot.
-func (c clock) AtSlot(slot phase0.Slot) Moment {
+func (c *clock) AtSlot(slot phase0.Slot) Moment {
 	return Moment{
-		clock: &c,
+		clock: c,
 		slot:  slot,
 	}
 }
 
 // AtEpoch returns the Moment at the start of the given epoch.
-func (c clock) AtEpoch(epoch phase0.Epoch) Moment {
+func (c *clock) AtEpoch(epoch phase0.Epoch) Moment {
 	return Moment{
-		clock: &c,
+		clock: c,
 		slot:  phase0.Slot(epoch) * c.SlotsPerEpoch,
 	}
 }
 
-// AtTime returns the Moment at the given time.
-func (c clock) AtTime(t time.Time) Moment {
+// AtSyncCommitteePeriod returns the Moment at the start of the given sync committee period.
+func (c *clock) AtSyncCommitteePeriod(period uint64) Moment {
+	return c.AtEpoch(phase0.Epoch(period) * c.EpochsPerSyncCommitteePeriod)
+}
+
+// AtTime returns the Moment at the given time, or the Moment
+// of the genesis slot if the given time is before genesis.
+func (c *clock) AtTime(t time.Time) Moment {
+	slot := c.GenesisSlot
+	if t.After(c.GenesisTime) {
+		slot += phase0.Slot(t.Sub(c.GenesisTime) / c.SlotDuration)
+	}
 	return Moment{
-		clock: &c,
-		slot:  phase0.Slot(t.Sub(c.GenesisTime) / c.SlotDuration),
+		clock: c,
+		slot:  slot,
 	}
 }
 
-// EverySlot returns a channel that emits the current Moment at each slot.
-func (c clock) EverySlot(ctx context.Context) <-chan Moment {
-	return c.every(ctx, func() time.Time {
-		return c.AtSlot(c.Now().Slot() + 1).Time()
-	})
+// UntilGenesis returns the duration until genesis,
+// or zero if genesis has passed.
+func (c *clock) UntilGenesis() time.Duration {
+	return max(c.GenesisTime.Sub(c.TimeSource.Now()), 0)
 }
 
-// EveryEpoch returns a channel that emits the current Moment at each epoch.
-func (c clock) EveryEpoch(ctx context.Context) <-chan Moment {
-	return c.every(ctx, func() time.Time {
-		return c.AtEpoch(c.Now().Epoch() + 1).Time()
-	})
+// WaitForGenesis blocks until genesis or until the context is cancelled.
+func (c *clock) WaitForGenesis(ctx context.Context) error {
+	until := c.UntilGenesis()
+	if until == 0 {
+		return nil
+	}
+	timer := c.TimeSource.NewTimer(until)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C():
+		return nil
+	}
+}
+
+// EverySlot returns a channel that emits the Moment of each slot.
+func (c *clock) EverySlot(ctx context.Context) <-chan Moment {
+	return c.every(ctx, Slots)
+}
+
+// EveryEpoch returns a channel that emits the Moment of each epoch.
+func (c *clock) EveryEpoch(ctx context.Context) <-chan Moment {
+	return c.every(ctx, Epochs)
+}
+
+// EveryPeriod returns a channel that emits the Moment of each sync committee period.
+func (c *clock) EveryPeriod(ctx context.Context) <-chan Moment {
+	return c.every(ctx, SyncCommitteePeriods)
+}
+
+// EveryOffset returns a channel that emits the Moment of each slot
+// at the given offset into the slot.
+func (c *clock) EveryOffset(ctx context.Context, offset time.Duration) <-chan Moment {
+	return c.every(ctx, SlotOffset(offset))
+}
+
+// EveryInterval returns a channel that emits the Moment of each slot at the start
+// of the given interval of the slot, which is divided into the given number
+// of intervals.
+func (c *clock) EveryInterval(ctx context.Context, interval, intervals uint64) <-chan Moment {
+	return c.every(ctx, SlotInterval(interval, intervals))
 }
 
-// every emits to the returned channel at the times returned by the given
-// function, until the context is cancelled.
-func (c *clock) every(ctx context.Context, next func() time.Time) <-chan Moment {
-	ch := make(chan Moment)
+// intervalOffset returns the offset into a slot of the start of the given
+// interval, when the slot is divided into the given number of intervals,
+// or zero if there are zero intervals.
+func (c *clock) intervalOffset(interval, intervals uint64) time.Duration {
+	if intervals == 0 {
+		return 0
+	}
+	return time.Duration(uint64(c.SlotDuration) * interval / intervals)
+}
+
+// withDeadline returns a copy of the parent context which is cancelled at the given
+// deadline. If TimeSource isn't SystemTime, the context is cancelled when the
+// TimeSource reaches the deadline, with context.DeadlineExceeded as its cause.
+func (c *clock) withDeadline(parent context.Context, deadline time.Time) (context.Context, context.CancelFunc) {
+	if c.TimeSource == SystemTime {
+		return context.WithDeadline(parent, deadline)
+	}
+	ctx, cancel := context.WithCancelCause(parent)
+	timer := c.TimeSource.NewTimer(deadline.Sub(c.TimeSource.Now()))
 	go func() {
-		defer close(ch)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-time.After(time.Until(next())):
-				ch <- c.Now()
-			}
+		select {
+		case <-timer.C():
+			cancel(context.DeadlineExceeded)
+		case <-ctx.Done():
+			timer.Stop()
 		}
 	}()
-	return ch
+	return ctx, func() { cancel(context.Canceled) }
+}
+
+// every emits the scheduled Moments of the given Cadence to the returned channel
+// until the context is cancelled. If the consumer falls behind, it receives
+// the latest Moment once it's ready.
+func (c *clock) every(ctx context.Context, cadence Cadence) <-chan Moment {
+	return tick(ctx, c, cadence, TickCoalesce, func(t Tick) Moment { return t.Moment })
 }
@@ -0,0 +1,166 @@
+package clock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMomentIntervalTime(t *testing.T) {
+	for _, slotDuration := range []time.Duration{12 * time.Second, 6 * time.Second} {
+		params := testParams
+		params.SlotDuration = slotDuration
+		m := New(params).AtSlot(10)
+
+		require.Equal(t, m.Time(), m.IntervalTime(0, 3))
+		require.Equal(t, m.Time().Add(slotDuration/3), m.IntervalTime(1, 3))
+		require.Equal(t, m.Time().Add(slotDuration*2/3), m.IntervalTime(2, 3))
+		require.Equal(t, m.Time().Add(slotDuration), m.IntervalTime(3, 3))
+		require.Equal(t, m.Time(), m.IntervalTime(1, 0))
+		require.Equal(t, m.Time().Add(time.Second), m.TimeAt(time.Second))
+	}
+}
+
+func TestSyncCommitteePeriodDefault(t *testing.T) {
+	params := testParams
+	params.EpochsPerSyncCommitteePeriod = 0
+	clock := New(params)
+
+	m := clock.AtEpoch(DefaultEpochsPerSyncCommitteePeriod + 1)
+	require.Equal(t, uint64(1), m.SyncCommitteePeriod())
+	require.Equal(t, clock.AtEpoch(DefaultEpochsPerSyncCommitteePeriod), clock.AtSyncCommitteePeriod(1))
+}
+
+func TestEveryInterval(t *testing.T) {
+	params := testParams
+	params.SlotDuration = 6 * time.Second
+	clock := NewFake(params)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	attestations := clock.EveryInterval(ctx, 1, 3)
+	aggregations := clock.EveryOffset(ctx, 4*time.Second)
+	clock.BlockUntilTickers(2)
+
+	for slot := phase0.Slot(0); slot < 3; slot++ {
+		require.True(t, clock.Source.AdvanceToNextTimer())
+		m := <-attestations
+		require.Equal(t, slot, m.Slot())
+		require.Equal(t, m.IntervalTime(1, 3), clock.Source.Now())
+		clock.BlockUntilTickers(2)
+
+		require.True(t, clock.Source.AdvanceToNextTimer())
+		m = <-aggregations
+		require.Equal(t, slot, m.Slot())
+		require.Equal(t, m.TimeAt(4*time.Second), clock.Source.Now())
+		clock.BlockUntilTickers(2)
+	}
+
+	// Starting exactly at an interval waits for the next slot's interval.
+	cancel()
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	clock.Set(clock.AtSlot(5).IntervalTime(1, 3))
+	attestations = clock.EveryInterval(ctx, 1, 3)
+	clock.BlockUntilTickers(1)
+	require.True(t, clock.Source.AdvanceToNextTimer())
+	require.Equal(t, phase0.Slot(6), (<-attestations).Slot())
+
+	// Zero intervals are the start of each slot.
+	require.Equal(t, Slots, SlotInterval(1, 0))
+
+	// Intervals beyond the slot are rejected.
+	require.Panics(t, func() { SlotInterval(3, 3) })
+	require.Panics(t, func() { clock.EveryInterval(ctx, 4, 3) })
+}
+
+func TestClockBeforeGenesis(t *testing.T) {
+	params := testParams
+	params.GenesisSlot = 64
+	clock := NewFake(params)
+	clock.Set(params.GenesisTime.Add(-30 * time.Second))
+
+	// Before genesis, the current Moment is the genesis slot.
+	require.Equal(t, phase0.Slot(64), clock.Now().Slot())
+	require.Equal(t, phase0.Epoch(2), clock.Now().Epoch())
+	require.Equal(t, 30*time.Second, clock.UntilGenesis())
+	require.Equal(t, 30*time.Second, clock.Now().Until())
+
+	// Times are relative to the genesis slot.
+	require.Equal(t, params.GenesisTime, clock.AtSlot(64).Time())
+	require.Equal(t, params.GenesisTime.Add(testParams.SlotDuration), clock.AtSlot(65).Time())
+	require.Equal(t, params.GenesisTime.Add(-testParams.SlotDuration), clock.AtSlot(63).Time())
+	require.Equal(t, phase0.Slot(65), clock.AtTime(params.GenesisTime.Add(13*time.Second)).Slot())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	slots := clock.EverySlot(ctx)
+	epochs := clock.EveryEpoch(ctx)
+	genesis := make(chan error)
+	go func() {
+		genesis <- clock.WaitForGenesis(ctx)
+	}()
+
+	// Tickers and WaitForGenesis wait for genesis.
+	clock.BlockUntilTickers(3)
+	clock.Advance(29 * time.Second)
+	requireNoTick(t, slots)
+	requireNoTick(t, genesis)
+
+	clock.Advance(time.Second)
+	require.NoError(t, <-genesis)
+	require.Equal(t, phase0.Slot(64), (<-slots).Slot())
+	require.Equal(t, phase0.Slot(64), (<-epochs).Slot())
+	require.Zero(t, clock.UntilGenesis())
+	require.NoError(t, clock.WaitForGenesis(ctx))
+
+	clock.BlockUntilTickers(2)
+	clock.Advance(testParams.SlotDuration)
+	require.Equal(t, phase0.Slot(65), (<-slots).Slot())
+
+	// WaitForGenesis returns when the context is cancelled.
+	clock.Set(params.GenesisTime.Add(-time.Second))
+	cancel()
+	require.ErrorIs(t, clock.WaitForGenesis(ctx), context.Canceled)
+}
+
+func TestMomentContext(t *testing.T) {
+	// AttestationPropagationSlotRange defaults to 32 slots.
+	clock := NewFake(testParams)
+	require.Equal(t, clock.AtSlot(43).Time(), clock.AtSlot(10).AttestationPropagationDeadline())
+
+	params := testParams
+	params.AttestationPropagationSlotRange = 4
+	clock = NewFake(params)
+	m := clock.AtSlot(10)
+	require.Equal(t, clock.AtSlot(15).Time(), m.AttestationPropagationDeadline())
+
+	ctx, cancel := m.ContextAt(context.Background(), 4*time.Second)
+	defer cancel()
+	propagationCtx, cancel := m.AttestationPropagationContext(context.Background())
+	defer cancel()
+
+	clock.BlockUntilTickers(2)
+	clock.Set(m.TimeAt(4*time.Second - time.Nanosecond))
+	require.NoError(t, ctx.Err())
+
+	clock.Advance(time.Nanosecond)
+	<-ctx.Done()
+	require.ErrorIs(t, context.Cause(ctx), context.DeadlineExceeded)
+	require.NoError(t, propagationCtx.Err())
+
+	clock.JumpToSlot(43)
+	<-propagationCtx.Done()
+	require.ErrorIs(t, context.Cause(propagationCtx), context.DeadlineExceeded)
+
+	// With the system's time, contexts have a deadline.
+	ctx, cancel = New(params).AtSlot(10).ContextAt(context.Background(), time.Second)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	require.Equal(t, m.TimeAt(time.Second), deadline)
+	require.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+}
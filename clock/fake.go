@@ -0,0 +1,191 @@
+package clock
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// FakeTimeSource is a TimeSource whose time only changes when it's
+// told to, which makes the timers it creates fire deterministically.
+type FakeTimeSource struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	timers []*fakeTimer
+}
+
+// NewFakeTimeSource returns a new FakeTimeSource starting at the given time.
+func NewFakeTimeSource(now time.Time) *FakeTimeSource {
+	s := &FakeTimeSource{now: now}
+	s.cond = sync.NewCond(&s.mu)
+	return s
+}
+
+// Now returns the current fake time.
+func (s *FakeTimeSource) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.now
+}
+
+// NewTimer returns a Timer which fires once the fake time has advanced
+// by the given duration. A non-positive duration fires immediately.
+func (s *FakeTimeSource) NewTimer(d time.Duration) Timer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := &fakeTimer{
+		source:   s,
+		deadline: s.now.Add(d),
+		c:        make(chan time.Time, 1),
+	}
+	if d <= 0 {
+		t.c <- s.now
+		return t
+	}
+	s.timers = append(s.timers, t)
+	s.cond.Broadcast()
+	return t
+}
+
+// Advance moves the fake time forward by the given duration and fires
+// the timers which are due, in the order of their deadlines.
+func (s *FakeTimeSource) Advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set(s.now.Add(d))
+}
+
+// Set sets the fake time and fires the timers which are due,
+// in the order of their deadlines. Setting the time backwards
+// doesn't fire any timer.
+func (s *FakeTimeSource) Set(t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set(t)
+}
+
+// AdvanceToNextTimer moves the fake time forward to the deadline of the
+// earliest pending timer and fires it. It returns false if no timer is pending.
+func (s *FakeTimeSource) AdvanceToNextTimer() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.timers) == 0 {
+		return false
+	}
+	next := slices.MinFunc(s.timers, func(a, b *fakeTimer) int {
+		return a.deadline.Compare(b.deadline)
+	})
+	s.set(next.deadline)
+	return true
+}
+
+// Timers returns the number of pending timers.
+func (s *FakeTimeSource) Timers() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.timers)
+}
+
+// BlockUntilTimers blocks until at least n timers are pending. It's used to
+// wait for tickers to be armed before advancing the time.
+func (s *FakeTimeSource) BlockUntilTimers(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(s.timers) < n {
+		s.cond.Wait()
+	}
+}
+
+func (s *FakeTimeSource) set(t time.Time) {
+	s.now = t
+
+	var due []*fakeTimer
+	s.timers = slices.DeleteFunc(s.timers, func(timer *fakeTimer) bool {
+		if timer.deadline.After(t) {
+			return false
+		}
+		due = append(due, timer)
+		return true
+	})
+	slices.SortStableFunc(due, func(a, b *fakeTimer) int {
+		return a.deadline.Compare(b.deadline)
+	})
+	for _, timer := range due {
+		timer.c <- t
+	}
+}
+
+type fakeTimer struct {
+	source   *FakeTimeSource
+	deadline time.Time
+	c        chan time.Time
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.source.mu.Lock()
+	defer t.source.mu.Unlock()
+	i := slices.Index(t.source.timers, t)
+	if i < 0 {
+		return false
+	}
+	t.source.timers = slices.Delete(t.source.timers, i, i+1)
+	return true
+}
+
+// Fake is a Clock driven by a FakeTimeSource, which starts at genesis
+// and only advances when told to.
+type Fake struct {
+	Clock
+
+	// Source is the FakeTimeSource of the Clock.
+	Source *FakeTimeSource
+}
+
+// NewFake returns a new Fake starting at the genesis time of the given Params.
+// The TimeSource of the Params is ignored.
+func NewFake(params Params) *Fake {
+	source := NewFakeTimeSource(params.GenesisTime)
+	params.TimeSource = source
+	return &Fake{
+		Clock:  New(params),
+		Source: source,
+	}
+}
+
+// Advance moves the time forward by the given duration,
+// firing the tickers which are due.
+func (f *Fake) Advance(d time.Duration) {
+	f.Source.Advance(d)
+}
+
+// Set sets the time, firing the tickers which are due.
+func (f *Fake) Set(t time.Time) {
+	f.Source.Set(t)
+}
+
+// JumpToSlot sets the time to the start of the given slot,
+// firing the tickers which are due.
+func (f *Fake) JumpToSlot(slot phase0.Slot) {
+	f.Source.Set(f.AtSlot(slot).Time())
+}
+
+// JumpToEpoch sets the time to the start of the given epoch,
+// firing the tickers which are due.
+func (f *Fake) JumpToEpoch(epoch phase0.Epoch) {
+	f.Source.Set(f.AtEpoch(epoch).Time())
+}
+
+// BlockUntilTickers blocks until at least n tickers are waiting to fire.
+// Call it before advancing the time to make sure that tickers
+// started in other goroutines observe the change.
+func (f *Fake) BlockUntilTickers(n int) {
+	f.Source.BlockUntilTimers(n)
+}
@@ -0,0 +1,93 @@
+package clock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+var testParams = Params{
+	GenesisTime:                  time.Unix(1606824023, 0),
+	SlotsPerEpoch:                32,
+	SlotDuration:                 12 * time.Second,
+	EpochsPerSyncCommitteePeriod: 256,
+}
+
+func TestFakeTimeSource(t *testing.T) {
+	start := time.Unix(1000, 0)
+	source := NewFakeTimeSource(start)
+	require.Equal(t, start, source.Now())
+
+	// Timers fire once due, in the order of their deadlines.
+	late := source.NewTimer(2 * time.Second)
+	early := source.NewTimer(time.Second)
+	stopped := source.NewTimer(time.Second)
+	require.Equal(t, 3, source.Timers())
+	require.True(t, stopped.Stop())
+	require.False(t, stopped.Stop())
+
+	source.Advance(500 * time.Millisecond)
+	require.Len(t, early.C(), 0)
+
+	require.True(t, source.AdvanceToNextTimer())
+	require.Equal(t, start.Add(time.Second), source.Now())
+	require.Equal(t, start.Add(time.Second), <-early.C())
+	require.Len(t, late.C(), 0)
+	require.False(t, early.Stop())
+
+	source.Set(start.Add(time.Minute))
+	require.Equal(t, start.Add(time.Minute), <-late.C())
+	require.Len(t, stopped.C(), 0)
+	require.Zero(t, source.Timers())
+	require.False(t, source.AdvanceToNextTimer())
+
+	// Non-positive durations fire immediately.
+	require.Equal(t, source.Now(), <-source.NewTimer(0).C())
+}
+
+func TestFakeEverySlot(t *testing.T) {
+	clock := NewFake(testParams)
+	require.Equal(t, phase0.Slot(0), clock.Now().Slot())
+	require.Equal(t, time.Duration(0), clock.Now().Until())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	slots := clock.EverySlot(ctx)
+
+	// Advancing within a slot doesn't tick.
+	clock.BlockUntilTickers(1)
+	clock.Advance(testParams.SlotDuration - time.Millisecond)
+	requireNoTick(t, slots)
+
+	clock.Advance(time.Millisecond)
+	require.Equal(t, phase0.Slot(1), (<-slots).Slot())
+
+	// Jumping over several slots ticks once, at the current slot.
+	clock.BlockUntilTickers(1)
+	clock.JumpToSlot(10)
+	require.Equal(t, phase0.Slot(10), (<-slots).Slot())
+
+	clock.BlockUntilTickers(1)
+	clock.JumpToEpoch(2)
+	require.Equal(t, phase0.Slot(64), (<-slots).Slot())
+	require.Equal(t, phase0.Epoch(2), clock.Now().Epoch())
+
+	// Cancelling stops the ticker.
+	clock.BlockUntilTickers(1)
+	cancel()
+	_, ok := <-slots
+	require.False(t, ok)
+	require.Zero(t, clock.Source.Timers())
+}
+
+func requireNoTick[T any](t *testing.T, ch <-chan T) {
+	t.Helper()
+	select {
+	case v := <-ch:
+		t.Fatalf("unexpected tick: %v", v)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
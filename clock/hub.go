@@ -0,0 +1,129 @@
+package clock
+
+import (
+	"context"
+	"sync"
+)
+
+// Hub broadcasts the ticks of a Clock to any number of subscribers, with a single
+// timer per Cadence which is shared by all of its subscribers, so that they tick
+// together. Subscribers can subscribe and unsubscribe at any time.
+type Hub struct {
+	clock      Clock
+	mu         sync.Mutex
+	broadcasts map[Cadence]*broadcast
+}
+
+type broadcast struct {
+	cancel      context.CancelFunc
+	subscribers map[*Subscription]struct{}
+}
+
+// NewHub returns a new Hub of the given Clock.
+func NewHub(clock Clock) *Hub {
+	return &Hub{
+		clock:      clock,
+		broadcasts: map[Cadence]*broadcast{},
+	}
+}
+
+// Subscribe returns a Subscription to the ticks of the given Cadence, which buffers
+// up to the given number of ticks. Ticks which don't fit in the buffer are dropped,
+// and counted in the Missed field of the next Tick which does, so that a slow
+// subscriber never delays the others.
+func (h *Hub) Subscribe(cadence Cadence, buffer int) *Subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s := &Subscription{
+		hub:     h,
+		cadence: cadence,
+		ch:      make(chan Tick, buffer),
+	}
+	b, ok := h.broadcasts[cadence]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		b = &broadcast{
+			cancel:      cancel,
+			subscribers: map[*Subscription]struct{}{},
+		}
+		h.broadcasts[cadence] = b
+		go h.broadcast(b, h.clock.Ticks(ctx, cadence, TickCatchUp))
+	}
+	b.subscribers[s] = struct{}{}
+	return s
+}
+
+// Close unsubscribes every Subscription and stops every timer of the Hub.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for cadence, b := range h.broadcasts {
+		b.cancel()
+		for s := range b.subscribers {
+			close(s.ch)
+		}
+		clear(b.subscribers)
+		delete(h.broadcasts, cadence)
+	}
+}
+
+// broadcast sends the given ticks to the subscribers of the given broadcast.
+func (h *Hub) broadcast(b *broadcast, ticks <-chan Tick) {
+	for tick := range ticks {
+		h.mu.Lock()
+		for s := range b.subscribers {
+			t := tick
+			t.Missed += s.missed
+			select {
+			case s.ch <- t:
+				s.missed = 0
+			default:
+				s.missed++
+			}
+		}
+		h.mu.Unlock()
+	}
+}
+
+func (h *Hub) unsubscribe(s *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	b, ok := h.broadcasts[s.cadence]
+	if !ok {
+		return
+	}
+	if _, ok := b.subscribers[s]; !ok {
+		return
+	}
+	delete(b.subscribers, s)
+	close(s.ch)
+
+	// Stop the timer once the last subscriber unsubscribes.
+	if len(b.subscribers) == 0 {
+		b.cancel()
+		delete(h.broadcasts, s.cadence)
+	}
+}
+
+// Subscription is a subscription to the ticks of a Cadence of a Hub.
+type Subscription struct {
+	hub     *Hub
+	cadence Cadence
+	ch      chan Tick
+	missed  int
+}
+
+// C returns the channel on which the ticks are delivered,
+// which is closed once unsubscribed.
+func (s *Subscription) C() <-chan Tick {
+	return s.ch
+}
+
+// Unsubscribe stops the delivery of ticks and closes the channel.
+// It's safe to call more than once.
+func (s *Subscription) Unsubscribe() {
+	s.hub.unsubscribe(s)
+}
@@ -0,0 +1,68 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub(t *testing.T) {
+	clock := NewFake(testParams)
+	hub := NewHub(clock)
+	defer hub.Close()
+
+	// Subscribers of the same Cadence share a timer.
+	a := hub.Subscribe(Slots, 4)
+	b := hub.Subscribe(Slots, 4)
+	slow := hub.Subscribe(Slots, 1)
+	attestations := hub.Subscribe(SlotInterval(1, 3), 1)
+	clock.BlockUntilTickers(2)
+	require.Equal(t, 2, clock.Source.Timers())
+
+	for slot := phase0.Slot(1); slot <= 3; slot++ {
+		clock.JumpToSlot(slot)
+		require.Equal(t, slot, (<-a.C()).Moment.Slot())
+		require.Equal(t, slot, (<-b.C()).Moment.Slot())
+		require.Equal(t, slot-1, (<-attestations.C()).Moment.Slot())
+		clock.BlockUntilTickers(2)
+	}
+
+	// The slow subscriber missed the ticks which didn't fit in its buffer.
+	require.Equal(t, phase0.Slot(1), (<-slow.C()).Moment.Slot())
+	clock.JumpToSlot(4)
+	tick := <-slow.C()
+	require.Equal(t, phase0.Slot(4), tick.Moment.Slot())
+	require.Equal(t, 2, tick.Missed)
+	require.Equal(t, phase0.Slot(4), (<-a.C()).Moment.Slot())
+
+	// Unsubscribing closes the channel, and the last unsubscribe stops the timer.
+	b.Unsubscribe()
+	b.Unsubscribe()
+	require.Equal(t, phase0.Slot(4), (<-b.C()).Moment.Slot())
+	_, ok := <-b.C()
+	require.False(t, ok)
+
+	attestations.Unsubscribe()
+	require.Eventually(t, func() bool {
+		return clock.Source.Timers() == 1
+	}, time.Second, time.Millisecond)
+
+	// Subscribers can join later.
+	c := hub.Subscribe(Slots, 1)
+	clock.BlockUntilTickers(1)
+	clock.JumpToSlot(5)
+	require.Equal(t, phase0.Slot(5), (<-c.C()).Moment.Slot())
+	require.Equal(t, phase0.Slot(5), (<-a.C()).Moment.Slot())
+
+	// Closing unsubscribes everyone.
+	hub.Close()
+	for _, s := range []*Subscription{a, slow, c} {
+		for range s.C() {
+		}
+	}
+	require.Eventually(t, func() bool {
+		return clock.Source.Timers() == 0
+	}, time.Second, time.Millisecond)
+}
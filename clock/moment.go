@@ -1,13 +1,24 @@
 package clock
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
+// ErrMomentWithoutClock is returned when unmarshalling into
+// a Moment which wasn't created by a Clock.
+var ErrMomentWithoutClock = errors.New("moment has no clock")
+
 // Moment represents an instant in time of the Beacon Chain
 // and provides conversions between slots, epochs and time.
+// Moments of the same Clock at the same slot are equal with ==,
+// so they can be used as map keys.
 type Moment struct {
 	clock *clock
 	slot  phase0.Slot
@@ -33,13 +44,163 @@ func (m Moment) Epoch() phase0.Epoch {
 	return phase0.Epoch(m.slot / m.clock.SlotsPerEpoch)
 }
 
+// SyncCommitteePeriod returns the sync committee period.
+func (m Moment) SyncCommitteePeriod() uint64 {
+	return uint64(m.Epoch() / m.clock.EpochsPerSyncCommitteePeriod)
+}
+
 // Time returns the time.
 func (m Moment) Time() time.Time {
-	return m.clock.GenesisTime.Add(time.Duration(m.slot) * m.clock.SlotDuration)
+	slotsSinceGenesis := int64(m.slot) - int64(m.clock.GenesisSlot)
+	return m.clock.GenesisTime.Add(time.Duration(slotsSinceGenesis) * m.clock.SlotDuration)
+}
+
+// TimeAt returns the time at the given offset into the slot.
+func (m Moment) TimeAt(offset time.Duration) time.Time {
+	return m.Time().Add(offset)
+}
+
+// IntervalTime returns the start time of the given interval of the slot,
+// which is divided into the given number of intervals. For example,
+// attestations are due at IntervalTime(1, 3) and aggregates at IntervalTime(2, 3).
+// Zero intervals are the start of the slot.
+func (m Moment) IntervalTime(interval, intervals uint64) time.Time {
+	return m.TimeAt(m.clock.intervalOffset(interval, intervals))
+}
+
+// ContextAt returns a copy of the parent context which is cancelled
+// at the given offset into the slot.
+func (m Moment) ContextAt(parent context.Context, offset time.Duration) (context.Context, context.CancelFunc) {
+	return m.clock.withDeadline(parent, m.TimeAt(offset))
+}
+
+// AttestationPropagationDeadline returns the time after which attestations
+// for the slot are no longer propagated, which is the end of the
+// AttestationPropagationSlotRange following the slot.
+func (m Moment) AttestationPropagationDeadline() time.Time {
+	return m.clock.AtSlot(m.slot + m.clock.AttestationPropagationSlotRange + 1).Time()
+}
+
+// AttestationPropagationContext returns a copy of the parent context which is
+// cancelled at the AttestationPropagationDeadline of the slot.
+func (m Moment) AttestationPropagationContext(parent context.Context) (context.Context, context.CancelFunc) {
+	return m.clock.withDeadline(parent, m.AttestationPropagationDeadline())
+}
+
+// Add returns the Moment the given number of slots after m, or before m
+// if slots is negative, in which case it's clamped at the genesis slot.
+func (m Moment) Add(slots int64) Moment {
+	slot := int64(m.slot) + slots
+	if slots < 0 && slot < int64(m.clock.GenesisSlot) {
+		// Don't move a Moment which is already before genesis forwards.
+		slot = min(int64(m.slot), int64(m.clock.GenesisSlot))
+	}
+	m.slot = phase0.Slot(slot)
+	return m
+}
+
+// AddEpochs returns the Moment the given number of epochs after m, or before m
+// if epochs is negative, in which case it's clamped at the genesis slot.
+func (m Moment) AddEpochs(epochs int64) Moment {
+	return m.Add(epochs * int64(m.clock.SlotsPerEpoch))
+}
+
+// Before returns true if m is before other.
+func (m Moment) Before(other Moment) bool {
+	return m.slot < other.slot
+}
+
+// After returns true if m is after other.
+func (m Moment) After(other Moment) bool {
+	return m.slot > other.slot
+}
+
+// Equal returns true if m and other are at the same slot.
+func (m Moment) Equal(other Moment) bool {
+	return m.slot == other.slot
+}
+
+// SlotInEpoch returns the index of the slot within its epoch.
+func (m Moment) SlotInEpoch() phase0.Slot {
+	return m.slot % m.clock.SlotsPerEpoch
+}
+
+// IsEpochStart returns true if the slot is the first slot of its epoch.
+func (m Moment) IsEpochStart() bool {
+	return m.SlotInEpoch() == 0
+}
+
+// IsEpochEnd returns true if the slot is the last slot of its epoch.
+func (m Moment) IsEpochEnd() bool {
+	return m.SlotInEpoch() == m.clock.SlotsPerEpoch-1
+}
+
+// Since returns the duration since m. It is a shorthand for
+// TimeSource.Now().Sub(m.Time())
+func (m Moment) Since() time.Duration {
+	return m.clock.TimeSource.Now().Sub(m.Time())
+}
+
+// String returns the epoch and slot of m and the time since m,
+// such as "epoch 123 slot 3939 (+4.1s)".
+func (m Moment) String() string {
+	since := m.Since().Round(100 * time.Millisecond)
+	sign := "+"
+	if since < 0 {
+		sign = "-"
+		since = -since
+	}
+	return fmt.Sprintf("epoch %d slot %d (%s%s)", m.Epoch(), m.slot, sign, since)
+}
+
+// MarshalText encodes the slot of m.
+func (m Moment) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatUint(uint64(m.slot), 10)), nil
+}
+
+// UnmarshalText decodes a slot encoded by MarshalText into m,
+// which must have been created by a Clock.
+func (m *Moment) UnmarshalText(text []byte) error {
+	if m.clock == nil {
+		return ErrMomentWithoutClock
+	}
+	slot, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid slot: %w", err)
+	}
+	m.slot = phase0.Slot(slot)
+	return nil
+}
+
+// momentJSON is the JSON representation of Moment, with
+// numbers as strings like in the Beacon API.
+type momentJSON struct {
+	Slot  string    `json:"slot"`
+	Epoch string    `json:"epoch"`
+	Time  time.Time `json:"time"`
+}
+
+// MarshalJSON encodes the slot, epoch and time of m.
+func (m Moment) MarshalJSON() ([]byte, error) {
+	return json.Marshal(momentJSON{
+		Slot:  strconv.FormatUint(uint64(m.slot), 10),
+		Epoch: strconv.FormatUint(uint64(m.Epoch()), 10),
+		Time:  m.Time().UTC(),
+	})
+}
+
+// UnmarshalJSON decodes the slot of a Moment encoded by MarshalJSON into m,
+// which must have been created by a Clock.
+func (m *Moment) UnmarshalJSON(data []byte) error {
+	var v momentJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	return m.UnmarshalText([]byte(v.Slot))
 }
 
 // Until returns the duration until m. It is a shorthand for
-// time.Until(m.Time())
+// m.Time().Sub(TimeSource.Now())
 func (m Moment) Until() time.Duration {
-	return time.Until(m.Time())
+	return m.Time().Sub(m.clock.TimeSource.Now())
 }
@@ -0,0 +1,85 @@
+package clock
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMomentArithmetic(t *testing.T) {
+	clock := NewFake(testParams)
+	m := clock.AtSlot(3939)
+	require.Equal(t, phase0.Slot(3940), m.Add(1).Slot())
+	require.Equal(t, phase0.Slot(3938), m.Add(-1).Slot())
+	require.Equal(t, phase0.Slot(3939+64), m.AddEpochs(2).Slot())
+	require.Equal(t, phase0.Slot(3939-32), m.AddEpochs(-1).Slot())
+
+	// Moments before genesis are clamped at the genesis slot.
+	require.Equal(t, phase0.Slot(0), clock.AtSlot(3).Add(-10).Slot())
+	require.Equal(t, phase0.Slot(0), clock.AtSlot(3).AddEpochs(-1).Slot())
+	params := testParams
+	params.GenesisSlot = 64
+	late := New(params)
+	require.Equal(t, phase0.Slot(64), late.AtSlot(100).Add(-50).Slot())
+	require.Equal(t, phase0.Slot(64), late.AtSlot(100).AddEpochs(-2).Slot())
+	require.Equal(t, phase0.Slot(10), late.AtSlot(10).Add(-1).Slot())
+
+	require.True(t, m.Before(m.Add(1)))
+	require.False(t, m.Before(m))
+	require.True(t, m.After(m.Add(-1)))
+	require.False(t, m.After(m))
+	require.True(t, m.Equal(clock.AtTime(m.Time().Add(time.Second))))
+	require.False(t, m.Equal(m.Add(1)))
+
+	require.Equal(t, phase0.Slot(3), m.SlotInEpoch())
+	require.False(t, m.IsEpochStart())
+	require.False(t, m.IsEpochEnd())
+	require.True(t, clock.AtEpoch(123).IsEpochStart())
+	require.True(t, clock.AtEpoch(123).Add(-1).IsEpochEnd())
+
+	clock.Set(m.TimeAt(4100 * time.Millisecond))
+	require.Equal(t, 4100*time.Millisecond, m.Since())
+	require.Equal(t, "epoch 123 slot 3939 (+4.1s)", m.String())
+	require.Equal(t, "epoch 123 slot 3940 (-7.9s)", m.Add(1).String())
+}
+
+func TestMomentMarshalling(t *testing.T) {
+	clock := New(testParams)
+	m := clock.AtSlot(3939)
+
+	text, err := m.MarshalText()
+	require.NoError(t, err)
+	require.Equal(t, "3939", string(text))
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"slot":"3939","epoch":"123","time":"2020-12-02T01:08:11Z"}`, string(data))
+
+	decoded := clock.AtSlot(0)
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.True(t, m.Equal(decoded))
+	require.Equal(t, m.Time(), decoded.Time())
+
+	decoded = clock.AtSlot(0)
+	require.NoError(t, decoded.UnmarshalText(text))
+	require.True(t, m.Equal(decoded))
+	require.Error(t, decoded.UnmarshalText([]byte("-1")))
+
+	// Moments must be created by a Clock to be decoded.
+	var zero Moment
+	require.ErrorIs(t, json.Unmarshal(data, &zero), ErrMomentWithoutClock)
+
+	// Moments can be map keys, and equal Moments are the same key.
+	require.True(t, clock.AtSlot(3939) == m)
+	byMoment := map[Moment]int{}
+	byMoment[clock.AtSlot(3939)]++
+	byMoment[clock.AtEpoch(123).Add(3)]++
+	byMoment[clock.AtTime(m.Time())]++
+	require.Equal(t, map[Moment]int{m: 3}, byMoment)
+	data, err = json.Marshal(byMoment)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"3939":3}`, string(data))
+}
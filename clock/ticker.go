@@ -0,0 +1,237 @@
+package clock
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Cadence is the schedule of a ticker, such as the start of every slot
+// or an offset into every epoch.
+type Cadence struct {
+	unit      cadenceUnit
+	offset    time.Duration
+	interval  uint64
+	intervals uint64
+	backlog   int
+}
+
+type cadenceUnit int
+
+const (
+	slotUnit cadenceUnit = iota
+	epochUnit
+	periodUnit
+)
+
+var (
+	// Slots is the Cadence of the start of every slot.
+	Slots = Cadence{unit: slotUnit}
+
+	// Epochs is the Cadence of the start of every epoch.
+	Epochs = Cadence{unit: epochUnit}
+
+	// SyncCommitteePeriods is the Cadence of the start of every sync committee period.
+	SyncCommitteePeriods = Cadence{unit: periodUnit}
+)
+
+// SlotOffset returns the Cadence of the given offset into every slot.
+func SlotOffset(offset time.Duration) Cadence {
+	return Slots.WithOffset(offset)
+}
+
+// SlotInterval returns the Cadence of the start of the given interval of
+// every slot, which is divided into the given number of intervals.
+// Zero intervals are the start of every slot, like Slots. It panics if the
+// interval isn't less than intervals, since it would be in the next slot.
+func SlotInterval(interval, intervals uint64) Cadence {
+	if intervals == 0 {
+		return Slots
+	}
+	if interval >= intervals {
+		panic(fmt.Sprintf("clock: interval %d of %d intervals is out of range", interval, intervals))
+	}
+	return Cadence{unit: slotUnit, interval: interval, intervals: intervals}
+}
+
+// WithOffset returns the Cadence shifted by the given offset into
+// every slot, epoch or sync committee period.
+func (c Cadence) WithOffset(offset time.Duration) Cadence {
+	c.offset += offset
+	return c
+}
+
+// WithBacklog returns the Cadence with the given maximum number of ticks
+// which TickCatchUp queues. The default backlog is the ticks of one epoch
+// for slot cadences, and a single tick for epoch and period cadences.
+func (c Cadence) WithBacklog(ticks int) Cadence {
+	c.backlog = ticks
+	return c
+}
+
+// TickPolicy determines what happens to the ticks of a ticker
+// which fire while the consumer isn't ready to receive them.
+type TickPolicy int
+
+const (
+	// TickDrop keeps the earliest pending tick and drops the ticks which fire
+	// until the consumer receives it, like time.Ticker.
+	TickDrop TickPolicy = iota
+
+	// TickCoalesce replaces the pending tick with the latest tick,
+	// so the consumer always receives the most recent one.
+	TickCoalesce
+
+	// TickCatchUp queues every tick, so the consumer receives all of them
+	// in order, possibly late. Beyond the backlog of the Cadence
+	// (see Cadence.WithBacklog), the oldest queued tick is dropped.
+	TickCatchUp
+)
+
+func (p TickPolicy) String() string {
+	switch p {
+	case TickDrop:
+		return "drop"
+	case TickCoalesce:
+		return "coalesce"
+	case TickCatchUp:
+		return "catch-up"
+	}
+	return "unknown"
+}
+
+// Tick is emitted by a ticker at a scheduled Moment of its Cadence.
+type Tick struct {
+	// Moment is the scheduled Moment, such as the slot at which the Tick is due.
+	Moment Moment
+
+	// Scheduled is the time at which the Tick was due.
+	Scheduled time.Time
+
+	// Fired is the time at which the ticker observed that the Tick was due.
+	Fired time.Time
+
+	// Missed is the number of ticks which were dropped or coalesced
+	// since the previous Tick which the consumer received.
+	Missed int
+}
+
+// Lateness returns how late the Tick fired after it was due.
+func (t Tick) Lateness() time.Duration {
+	return t.Fired.Sub(t.Scheduled)
+}
+
+// Ticks returns a channel that emits a Tick at each scheduled Moment of the given
+// Cadence, handling the ticks which the consumer isn't ready to receive
+// according to the given TickPolicy.
+func (c *clock) Ticks(ctx context.Context, cadence Cadence, policy TickPolicy) <-chan Tick {
+	return tick(ctx, c, cadence, policy, func(t Tick) Tick { return t })
+}
+
+// tick emits to the returned channel at each scheduled Moment of the given
+// Cadence, until the context is cancelled. Scheduling is independent of the
+// consumer, so that the ticks which the consumer isn't ready to receive are
+// handled according to the given TickPolicy.
+func tick[T any](ctx context.Context, c *clock, cadence Cadence, policy TickPolicy, convert func(Tick) T) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+
+		var (
+			pending  []Tick
+			missed   int
+			next, at = c.scheduleAfter(cadence, c.TimeSource.Now())
+			timer    = c.TimeSource.NewTimer(at.Sub(c.TimeSource.Now()))
+		)
+		defer func() { timer.Stop() }()
+
+		for {
+			var (
+				out  chan<- T
+				head T
+			)
+			if len(pending) > 0 {
+				out = ch
+				head = convert(pending[0])
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C():
+				now := c.TimeSource.Now()
+				for !at.After(now) {
+					t := Tick{Moment: next, Scheduled: at, Fired: now}
+					switch {
+					case len(pending) == 0 || policy == TickCatchUp:
+						if len(pending) >= c.backlog(cadence) {
+							// Drop the oldest tick to bound the backlog, counting it
+							// as missed by the tick which the consumer receives next.
+							missed += pending[0].Missed + 1
+							pending = pending[1:]
+							if len(pending) > 0 {
+								pending[0].Missed += missed
+								missed = 0
+							}
+						}
+						t.Missed = missed
+						missed = 0
+						pending = append(pending, t)
+					case policy == TickCoalesce:
+						t.Missed = pending[0].Missed + 1
+						pending[0] = t
+					default:
+						missed++
+					}
+					next, at = c.scheduleAfter(cadence, at)
+				}
+				timer = c.TimeSource.NewTimer(at.Sub(now))
+			case out <- head:
+				pending = pending[1:]
+			}
+		}
+	}()
+	return ch
+}
+
+// backlog returns the maximum number of ticks
+// which TickCatchUp queues for the given Cadence.
+func (c *clock) backlog(cadence Cadence) int {
+	switch {
+	case cadence.backlog > 0:
+		return cadence.backlog
+	case cadence.unit == slotUnit:
+		return max(int(c.SlotsPerEpoch), 1)
+	}
+	return 1
+}
+
+// scheduleAfter returns the first scheduled Moment of the given Cadence
+// which is due after the given time, and the time at which it's due.
+func (c *clock) scheduleAfter(cadence Cadence, t time.Time) (Moment, time.Time) {
+	offset := cadence.offset
+	if cadence.intervals > 0 {
+		offset += c.intervalOffset(cadence.interval, cadence.intervals)
+	}
+
+	m := c.AtTime(t)
+	switch cadence.unit {
+	case epochUnit:
+		m = c.AtEpoch(m.Epoch())
+	case periodUnit:
+		m = c.AtSyncCommitteePeriod(m.SyncCommitteePeriod())
+	}
+	for {
+		if at := m.TimeAt(offset); at.After(t) {
+			return m, at
+		}
+		switch cadence.unit {
+		case epochUnit:
+			m = c.AtEpoch(m.Epoch() + 1)
+		case periodUnit:
+			m = c.AtSyncCommitteePeriod(m.SyncCommitteePeriod() + 1)
+		default:
+			m = c.AtSlot(m.Slot() + 1)
+		}
+	}
+}
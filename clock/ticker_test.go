@@ -0,0 +1,136 @@
+package clock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTickPolicy(t *testing.T) {
+	slotDuration := testParams.SlotDuration
+
+	// The ticker falls behind by 3 slots, as if the process was suspended.
+	behind := func(t *testing.T, policy TickPolicy) (*Fake, <-chan Tick) {
+		clock := NewFake(testParams)
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		ticks := clock.Ticks(ctx, Slots, policy)
+		clock.BlockUntilTickers(1)
+		clock.JumpToSlot(3)
+		return clock, ticks
+	}
+
+	t.Run("drop", func(t *testing.T) {
+		clock, ticks := behind(t, TickDrop)
+		tick := <-ticks
+		require.Equal(t, phase0.Slot(1), tick.Moment.Slot())
+		require.Equal(t, clock.AtSlot(1).Time(), tick.Scheduled)
+		require.Equal(t, clock.AtSlot(3).Time(), tick.Fired)
+		require.Equal(t, 2*slotDuration, tick.Lateness())
+		require.Zero(t, tick.Missed)
+
+		clock.BlockUntilTickers(1)
+		clock.JumpToSlot(4)
+		tick = <-ticks
+		require.Equal(t, phase0.Slot(4), tick.Moment.Slot())
+		require.Zero(t, tick.Lateness())
+		require.Equal(t, 2, tick.Missed)
+	})
+
+	t.Run("coalesce", func(t *testing.T) {
+		clock, ticks := behind(t, TickCoalesce)
+		tick := <-ticks
+		require.Equal(t, phase0.Slot(3), tick.Moment.Slot())
+		require.Zero(t, tick.Lateness())
+		require.Equal(t, 2, tick.Missed)
+
+		clock.BlockUntilTickers(1)
+		clock.Advance(slotDuration)
+		tick = <-ticks
+		require.Equal(t, phase0.Slot(4), tick.Moment.Slot())
+		require.Zero(t, tick.Missed)
+	})
+
+	t.Run("catch-up", func(t *testing.T) {
+		_, ticks := behind(t, TickCatchUp)
+		for slot := phase0.Slot(1); slot <= 3; slot++ {
+			tick := <-ticks
+			require.Equal(t, slot, tick.Moment.Slot())
+			require.Equal(t, time.Duration(3-slot)*slotDuration, tick.Lateness())
+			require.Zero(t, tick.Missed)
+		}
+		requireNoTick(t, ticks)
+	})
+
+	t.Run("catch-up backlog", func(t *testing.T) {
+		// At most SlotsPerEpoch ticks are queued, and older ones are missed.
+		clock := NewFake(testParams)
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		ticks := clock.Ticks(ctx, Slots, TickCatchUp)
+		clock.BlockUntilTickers(1)
+		clock.JumpToSlot(40)
+
+		tick := <-ticks
+		require.Equal(t, phase0.Slot(9), tick.Moment.Slot())
+		require.Equal(t, 8, tick.Missed)
+		for slot := phase0.Slot(10); slot <= 40; slot++ {
+			tick := <-ticks
+			require.Equal(t, slot, tick.Moment.Slot())
+			require.Zero(t, tick.Missed)
+		}
+		requireNoTick(t, ticks)
+
+		// Epoch cadences queue a single tick by default.
+		clock = NewFake(testParams)
+		ticks = clock.Ticks(ctx, Epochs, TickCatchUp)
+		clock.BlockUntilTickers(1)
+		clock.JumpToSlot(3 * testParams.SlotsPerEpoch)
+		tick = <-ticks
+		require.Equal(t, phase0.Epoch(3), tick.Moment.Epoch())
+		require.Equal(t, 2, tick.Missed)
+		requireNoTick(t, ticks)
+
+		// The backlog can be set per Cadence.
+		clock = NewFake(testParams)
+		ticks = clock.Ticks(ctx, Slots.WithBacklog(2), TickCatchUp)
+		clock.BlockUntilTickers(1)
+		clock.JumpToSlot(5)
+		tick = <-ticks
+		require.Equal(t, phase0.Slot(4), tick.Moment.Slot())
+		require.Equal(t, 3, tick.Missed)
+		require.Equal(t, phase0.Slot(5), (<-ticks).Moment.Slot())
+		requireNoTick(t, ticks)
+	})
+}
+
+func TestCadence(t *testing.T) {
+	clock := NewFake(testParams)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// An offset into every epoch.
+	ticks := clock.Ticks(ctx, Epochs.WithOffset(time.Second), TickCatchUp)
+	clock.BlockUntilTickers(1)
+	require.True(t, clock.Source.AdvanceToNextTimer())
+	tick := <-ticks
+	require.Equal(t, phase0.Slot(0), tick.Moment.Slot())
+	require.Equal(t, clock.AtEpoch(0).TimeAt(time.Second), tick.Scheduled)
+
+	clock.BlockUntilTickers(1)
+	require.True(t, clock.Source.AdvanceToNextTimer())
+	tick = <-ticks
+	require.Equal(t, phase0.Epoch(1), tick.Moment.Epoch())
+	require.Equal(t, clock.AtEpoch(1).TimeAt(time.Second), tick.Scheduled)
+
+	// Every sync committee period.
+	ticks = clock.Ticks(ctx, SyncCommitteePeriods, TickCatchUp)
+	clock.BlockUntilTickers(2)
+	clock.Set(clock.AtSyncCommitteePeriod(1).Time())
+	tick = <-ticks
+	require.Equal(t, uint64(1), tick.Moment.SyncCommitteePeriod())
+	require.Equal(t, clock.AtEpoch(256).Time(), tick.Scheduled)
+}
@@ -0,0 +1,43 @@
+package clock
+
+import "time"
+
+// TimeSource provides the current time and timers to Clock.
+type TimeSource interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer returns a Timer which fires after the given duration.
+	NewTimer(time.Duration) Timer
+}
+
+// Timer is a single-use timer created by a TimeSource.
+type Timer interface {
+	// C returns the channel on which the time is delivered when the Timer fires.
+	C() <-chan time.Time
+
+	// Stop prevents the Timer from firing. It returns false if
+	// the Timer has already fired or been stopped.
+	Stop() bool
+}
+
+// SystemTime is the TimeSource of the system's clock.
+var SystemTime TimeSource = systemTime{}
+
+type systemTime struct{}
+
+func (systemTime) Now() time.Time {
+	return time.Now()
+}
+
+func (systemTime) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+type systemTimer struct {
+	*time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
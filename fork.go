@@ -0,0 +1,72 @@
+package beacon
+
+import (
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Fork is an upgrade of the Beacon Chain, scheduled at a given epoch.
+type Fork struct {
+	DataVersion spec.DataVersion
+	Version     phase0.Version
+	Epoch       phase0.Epoch
+}
+
+// Forks returns the fork schedule in chronological order,
+// starting with the genesis (phase0) fork.
+func (s *Spec) Forks() []Fork {
+	return []Fork{
+		{spec.DataVersionPhase0, s.GenesisForkVersion, 0},
+		{spec.DataVersionAltair, s.AltairForkVersion, s.AltairForkEpoch},
+		{spec.DataVersionBellatrix, s.BellatrixForkVersion, s.BellatrixForkEpoch},
+		{spec.DataVersionCapella, s.CapellaForkVersion, s.CapellaForkEpoch},
+		{spec.DataVersionDeneb, s.DenebForkVersion, s.DenebForkEpoch},
+		{spec.DataVersionElectra, s.ElectraForkVersion, s.ElectraForkEpoch},
+		{spec.DataVersionFulu, s.FuluForkVersion, s.FuluForkEpoch},
+	}
+}
+
+// ForkAtEpoch returns the fork which is active at the given epoch.
+func (s *Spec) ForkAtEpoch(epoch phase0.Epoch) Fork {
+	forks := s.Forks()
+	active := forks[0]
+	for _, fork := range forks[1:] {
+		if fork.Epoch > epoch {
+			break
+		}
+		active = fork
+	}
+	return active
+}
+
+// ForkVersionAtEpoch returns the fork version which is active at the given epoch.
+func (s *Spec) ForkVersionAtEpoch(epoch phase0.Epoch) phase0.Version {
+	return s.ForkAtEpoch(epoch).Version
+}
+
+// DataVersionAtEpoch returns the data version which is active at the given epoch.
+func (s *Spec) DataVersionAtEpoch(epoch phase0.Epoch) spec.DataVersion {
+	return s.ForkAtEpoch(epoch).DataVersion
+}
+
+// DataVersionAtSlot returns the data version which is active at the given slot.
+func (s *Spec) DataVersionAtSlot(slot phase0.Slot) spec.DataVersion {
+	return s.DataVersionAtEpoch(s.EpochFromSlot(slot))
+}
+
+// NextFork returns the first fork scheduled after the given epoch,
+// or false if no fork is scheduled.
+func (s *Spec) NextFork(epoch phase0.Epoch) (Fork, bool) {
+	for _, fork := range s.Forks() {
+		if fork.Epoch > epoch && fork.Epoch != s.FarFutureEpoch {
+			return fork, true
+		}
+	}
+	return Fork{}, false
+}
+
+// IsForkActive returns true if the fork of the given data version
+// is active at the given epoch.
+func (s *Spec) IsForkActive(version spec.DataVersion, epoch phase0.Epoch) bool {
+	return s.DataVersionAtEpoch(epoch) >= version
+}
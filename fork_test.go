@@ -0,0 +1,67 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecForkAtEpoch(t *testing.T) {
+	tests := []struct {
+		epoch       phase0.Epoch
+		dataVersion spec.DataVersion
+		version     phase0.Version
+	}{
+		{0, spec.DataVersionPhase0, phase0.Version{0x00, 0x00, 0x00, 0x00}},
+		{74239, spec.DataVersionPhase0, phase0.Version{0x00, 0x00, 0x00, 0x00}},
+		{74240, spec.DataVersionAltair, phase0.Version{0x01, 0x00, 0x00, 0x00}},
+		{194048, spec.DataVersionCapella, phase0.Version{0x03, 0x00, 0x00, 0x00}},
+		{364031, spec.DataVersionDeneb, phase0.Version{0x04, 0x00, 0x00, 0x00}},
+		{364032, spec.DataVersionElectra, phase0.Version{0x05, 0x00, 0x00, 0x00}},
+		{500000, spec.DataVersionFulu, phase0.Version{0x06, 0x00, 0x00, 0x00}},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.dataVersion, Mainnet.DataVersionAtEpoch(test.epoch), "epoch %d", test.epoch)
+		require.Equal(t, test.version, Mainnet.ForkVersionAtEpoch(test.epoch), "epoch %d", test.epoch)
+		require.Equal(t, test.dataVersion, Mainnet.DataVersionAtSlot(Mainnet.StartSlot(test.epoch)), "epoch %d", test.epoch)
+	}
+
+	// Networks which launched with later forks skip the earlier ones.
+	require.Equal(t, spec.DataVersionDeneb, Hoodi.DataVersionAtEpoch(0))
+	require.True(t, Hoodi.IsForkActive(spec.DataVersionElectra, 2048))
+	require.False(t, Hoodi.IsForkActive(spec.DataVersionElectra, 2047))
+}
+
+func TestSpecNextFork(t *testing.T) {
+	fork, ok := Mainnet.NextFork(300000)
+	require.True(t, ok)
+	require.Equal(t, spec.DataVersionElectra, fork.DataVersion)
+	require.Equal(t, Mainnet.ElectraForkEpoch, fork.Epoch)
+
+	fork, ok = Hoodi.NextFork(0)
+	require.True(t, ok)
+	require.Equal(t, spec.DataVersionElectra, fork.DataVersion)
+
+	_, ok = Mainnet.NextFork(Mainnet.FuluForkEpoch)
+	require.False(t, ok)
+
+	// Unscheduled forks are never next.
+	unscheduled := *Mainnet
+	unscheduled.FuluForkEpoch = unscheduled.FarFutureEpoch
+	_, ok = unscheduled.NextFork(Mainnet.ElectraForkEpoch)
+	require.False(t, ok)
+}
+
+func TestNetworksForkSchedule(t *testing.T) {
+	for _, network := range KnownNetworks() {
+		s, ok := LookupNetwork(network)
+		require.True(t, ok)
+		forks := s.Forks()
+		for i := 1; i < len(forks); i++ {
+			require.GreaterOrEqual(t, forks[i].Epoch, forks[i-1].Epoch, "%s: %s", network, forks[i].DataVersion)
+			require.NotEqual(t, forks[i].Version, forks[i-1].Version, "%s: %s", network, forks[i].DataVersion)
+		}
+	}
+}
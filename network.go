@@ -1,7 +1,13 @@
 package beacon
 
 import (
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"math"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
@@ -14,11 +20,61 @@ func (n Network) String() string {
 	return string(n)
 }
 
-var Networks = map[Network]*Spec{
-	Mainnet.Network: Mainnet,
-	Holesky.Network: Holesky,
-	Sepolia.Network: Sepolia,
-	Hoodi.Network:   Hoodi,
+// ErrNetworkExists is returned when registering a Network which already exists.
+var ErrNetworkExists = errors.New("network already exists")
+
+var (
+	// networks contains the Spec of every known Network.
+	networks = map[Network]*Spec{
+		Mainnet.Network: Mainnet,
+		Holesky.Network: Holesky,
+		Sepolia.Network: Sepolia,
+		Hoodi.Network:   Hoodi,
+	}
+	networksMu sync.RWMutex
+)
+
+// LookupNetwork returns the Spec of the given Network, or false if it isn't
+// known. Custom networks can be added with RegisterNetwork.
+func LookupNetwork(network Network) (*Spec, bool) {
+	networksMu.RLock()
+	defer networksMu.RUnlock()
+
+	spec, ok := networks[network]
+	return spec, ok
+}
+
+// KnownNetworks returns the name of every known Network, in alphabetical order.
+func KnownNetworks() []Network {
+	networksMu.RLock()
+	defer networksMu.RUnlock()
+
+	names := make([]Network, 0, len(networks))
+	for network := range networks {
+		names = append(names, network)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// RegisterNetwork validates the given Spec and adds it to the known networks
+// under the given name (see LookupNetwork).
+func RegisterNetwork(network Network, spec *Spec) error {
+	if network == "" {
+		return fmt.Errorf("%w: empty network name", ErrInvalidSpec)
+	}
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+
+	networksMu.Lock()
+	defer networksMu.Unlock()
+	if _, ok := networks[network]; ok {
+		return fmt.Errorf("%w: %s", ErrNetworkExists, network)
+	}
+	spec.Network = network
+	networks[network] = spec
+	return nil
 }
 
 var (
@@ -27,6 +83,7 @@ var (
 		GenesisTime:                          time.Unix(1606824023, 0),
 		GenesisSlot:                          0,
 		GenesisForkVersion:                   phase0.Version{0x0, 0x0, 0x0, 0x0},
+		GenesisValidatorsRoot:                mustDecodeRoot("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"),
 		FarFutureEpoch:                       phase0.Epoch(math.MaxUint64),
 		SlotsPerEpoch:                        32,
 		SecondsPerSlot:                       12,
@@ -34,13 +91,35 @@ var (
 		TargetCommitteeSize:                  128,
 		TargetAggregatorsPerCommittee:        16,
 		AttestationSubnetCount:               64,
+		ShuffleRoundCount:                    90,
+		MaxEffectiveBalance:                  32_000_000_000,
+		MaxEffectiveBalanceElectra:           2_048_000_000_000,
+		EffectiveBalanceIncrement:            1_000_000_000,
+		MaxSeedLookahead:                     4,
+		MinValidatorWithdrawabilityDelay:     256,
+		MaxDeposits:                          16,
+		MinPerEpochChurnLimit:                4,
+		ChurnLimitQuotient:                   65536,
+		MaxPerEpochActivationChurnLimit:      8,
+		MinPerEpochChurnLimitElectra:         128_000_000_000,
+		MaxPerEpochActivationExitChurnLimit:  256_000_000_000,
 		AttestationPropagationSlotRange:      32,
 		SyncCommitteeSize:                    512,
 		SyncCommitteeSubnetCount:             4,
 		TargetAggregatorsPerSyncSubcommittee: 16,
 		EpochsPerSyncCommitteePeriod:         256,
+		AltairForkVersion:                    phase0.Version{0x01, 0x00, 0x00, 0x00},
 		AltairForkEpoch:                      74240,
+		BellatrixForkVersion:                 phase0.Version{0x02, 0x00, 0x00, 0x00},
 		BellatrixForkEpoch:                   144896,
+		CapellaForkVersion:                   phase0.Version{0x03, 0x00, 0x00, 0x00},
+		CapellaForkEpoch:                     194048,
+		DenebForkVersion:                     phase0.Version{0x04, 0x00, 0x00, 0x00},
+		DenebForkEpoch:                       269568,
+		ElectraForkVersion:                   phase0.Version{0x05, 0x00, 0x00, 0x00},
+		ElectraForkEpoch:                     364032,
+		FuluForkVersion:                      phase0.Version{0x06, 0x00, 0x00, 0x00},
+		FuluForkEpoch:                        411392,
 
 		DomainBeaconProposer:              [4]byte{0, 0, 0, 0},
 		DomainBeaconAttester:              [4]byte{1, 0, 0, 0},
@@ -61,6 +140,7 @@ var (
 		GenesisTime:                          time.Unix(1695902400, 0),
 		GenesisSlot:                          0,
 		GenesisForkVersion:                   phase0.Version{0x01, 0x01, 0x70, 0x00},
+		GenesisValidatorsRoot:                mustDecodeRoot("0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1"),
 		FarFutureEpoch:                       phase0.Epoch(math.MaxUint64),
 		SlotsPerEpoch:                        32,
 		SecondsPerSlot:                       12,
@@ -68,13 +148,35 @@ var (
 		TargetCommitteeSize:                  128,
 		TargetAggregatorsPerCommittee:        16,
 		AttestationSubnetCount:               64,
+		ShuffleRoundCount:                    90,
+		MaxEffectiveBalance:                  32_000_000_000,
+		MaxEffectiveBalanceElectra:           2_048_000_000_000,
+		EffectiveBalanceIncrement:            1_000_000_000,
+		MaxSeedLookahead:                     4,
+		MinValidatorWithdrawabilityDelay:     256,
+		MaxDeposits:                          16,
+		MinPerEpochChurnLimit:                4,
+		ChurnLimitQuotient:                   65536,
+		MaxPerEpochActivationChurnLimit:      8,
+		MinPerEpochChurnLimitElectra:         128_000_000_000,
+		MaxPerEpochActivationExitChurnLimit:  256_000_000_000,
 		AttestationPropagationSlotRange:      32,
 		SyncCommitteeSize:                    512,
 		SyncCommitteeSubnetCount:             4,
 		TargetAggregatorsPerSyncSubcommittee: 16,
 		EpochsPerSyncCommitteePeriod:         256,
+		AltairForkVersion:                    phase0.Version{0x02, 0x01, 0x70, 0x00},
 		AltairForkEpoch:                      0,
+		BellatrixForkVersion:                 phase0.Version{0x03, 0x01, 0x70, 0x00},
 		BellatrixForkEpoch:                   0,
+		CapellaForkVersion:                   phase0.Version{0x04, 0x01, 0x70, 0x00},
+		CapellaForkEpoch:                     256,
+		DenebForkVersion:                     phase0.Version{0x05, 0x01, 0x70, 0x00},
+		DenebForkEpoch:                       29696,
+		ElectraForkVersion:                   phase0.Version{0x06, 0x01, 0x70, 0x00},
+		ElectraForkEpoch:                     115968,
+		FuluForkVersion:                      phase0.Version{0x07, 0x01, 0x70, 0x00},
+		FuluForkEpoch:                        165120,
 
 		DomainBeaconProposer:              [4]byte{0, 0, 0, 0},
 		DomainBeaconAttester:              [4]byte{1, 0, 0, 0},
@@ -95,6 +197,7 @@ var (
 		GenesisTime:                          time.Unix(1655733600, 0),
 		GenesisSlot:                          0,
 		GenesisForkVersion:                   phase0.Version{0x90, 0x0, 0x0, 0x69},
+		GenesisValidatorsRoot:                mustDecodeRoot("0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078"),
 		FarFutureEpoch:                       phase0.Epoch(math.MaxUint64),
 		SlotsPerEpoch:                        32,
 		SecondsPerSlot:                       12,
@@ -102,13 +205,35 @@ var (
 		TargetCommitteeSize:                  128,
 		TargetAggregatorsPerCommittee:        16,
 		AttestationSubnetCount:               64,
+		ShuffleRoundCount:                    90,
+		MaxEffectiveBalance:                  32_000_000_000,
+		MaxEffectiveBalanceElectra:           2_048_000_000_000,
+		EffectiveBalanceIncrement:            1_000_000_000,
+		MaxSeedLookahead:                     4,
+		MinValidatorWithdrawabilityDelay:     256,
+		MaxDeposits:                          16,
+		MinPerEpochChurnLimit:                4,
+		ChurnLimitQuotient:                   65536,
+		MaxPerEpochActivationChurnLimit:      8,
+		MinPerEpochChurnLimitElectra:         128_000_000_000,
+		MaxPerEpochActivationExitChurnLimit:  256_000_000_000,
 		AttestationPropagationSlotRange:      32,
 		SyncCommitteeSize:                    512,
 		SyncCommitteeSubnetCount:             4,
 		TargetAggregatorsPerSyncSubcommittee: 16,
 		EpochsPerSyncCommitteePeriod:         256,
+		AltairForkVersion:                    phase0.Version{0x90, 0x00, 0x00, 0x70},
 		AltairForkEpoch:                      50,
+		BellatrixForkVersion:                 phase0.Version{0x90, 0x00, 0x00, 0x71},
 		BellatrixForkEpoch:                   100,
+		CapellaForkVersion:                   phase0.Version{0x90, 0x00, 0x00, 0x72},
+		CapellaForkEpoch:                     56832,
+		DenebForkVersion:                     phase0.Version{0x90, 0x00, 0x00, 0x73},
+		DenebForkEpoch:                       132608,
+		ElectraForkVersion:                   phase0.Version{0x90, 0x00, 0x00, 0x74},
+		ElectraForkEpoch:                     222464,
+		FuluForkVersion:                      phase0.Version{0x90, 0x00, 0x00, 0x75},
+		FuluForkEpoch:                        272640,
 
 		DomainBeaconProposer:              [4]byte{0, 0, 0, 0},
 		DomainBeaconAttester:              [4]byte{1, 0, 0, 0},
@@ -129,6 +254,7 @@ var (
 		GenesisTime:                          time.Unix(1742212800+600, 0),
 		GenesisSlot:                          0,
 		GenesisForkVersion:                   phase0.Version{0x10, 0x00, 0x09, 0x10},
+		GenesisValidatorsRoot:                mustDecodeRoot("0x212f13fc4df078b6cb7db228f1c8307566dcecf900867401a92023d7ba99cb5f"),
 		FarFutureEpoch:                       phase0.Epoch(math.MaxUint64),
 		SlotsPerEpoch:                        32,
 		SecondsPerSlot:                       12,
@@ -136,13 +262,35 @@ var (
 		TargetCommitteeSize:                  128,
 		TargetAggregatorsPerCommittee:        16,
 		AttestationSubnetCount:               64,
+		ShuffleRoundCount:                    90,
+		MaxEffectiveBalance:                  32_000_000_000,
+		MaxEffectiveBalanceElectra:           2_048_000_000_000,
+		EffectiveBalanceIncrement:            1_000_000_000,
+		MaxSeedLookahead:                     4,
+		MinValidatorWithdrawabilityDelay:     256,
+		MaxDeposits:                          16,
+		MinPerEpochChurnLimit:                4,
+		ChurnLimitQuotient:                   65536,
+		MaxPerEpochActivationChurnLimit:      8,
+		MinPerEpochChurnLimitElectra:         128_000_000_000,
+		MaxPerEpochActivationExitChurnLimit:  256_000_000_000,
 		AttestationPropagationSlotRange:      32,
 		SyncCommitteeSize:                    512,
 		SyncCommitteeSubnetCount:             4,
 		TargetAggregatorsPerSyncSubcommittee: 16,
 		EpochsPerSyncCommitteePeriod:         256,
+		AltairForkVersion:                    phase0.Version{0x20, 0x00, 0x09, 0x10},
 		AltairForkEpoch:                      0,
+		BellatrixForkVersion:                 phase0.Version{0x30, 0x00, 0x09, 0x10},
 		BellatrixForkEpoch:                   0,
+		CapellaForkVersion:                   phase0.Version{0x40, 0x00, 0x09, 0x10},
+		CapellaForkEpoch:                     0,
+		DenebForkVersion:                     phase0.Version{0x50, 0x00, 0x09, 0x10},
+		DenebForkEpoch:                       0,
+		ElectraForkVersion:                   phase0.Version{0x60, 0x00, 0x09, 0x10},
+		ElectraForkEpoch:                     2048,
+		FuluForkVersion:                      phase0.Version{0x70, 0x00, 0x09, 0x10},
+		FuluForkEpoch:                        50688,
 
 		DomainBeaconProposer:              [4]byte{0, 0, 0, 0},
 		DomainBeaconAttester:              [4]byte{1, 0, 0, 0},
@@ -158,3 +306,11 @@ var (
 		DomainApplicationBuilder:          [4]byte{0, 0, 0, 1},
 	}
 )
+
+func mustDecodeRoot(s string) phase0.Root {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil || len(b) != len(phase0.Root{}) {
+		panic(fmt.Sprintf("invalid root: %s", s))
+	}
+	return phase0.Root(b)
+}
@@ -0,0 +1,195 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ssvlabs/beacon-kit"
+)
+
+// CircuitBreaker configures a circuit breaker for each client, which stops calling
+// a client after consecutive failures, and tries it again after a timeout.
+// The zero CircuitBreaker disables circuit breaking.
+type CircuitBreaker struct {
+	// Failures is the number of consecutive failed calls which open the breaker.
+	Failures int
+
+	// OpenTimeout is the duration for which an open breaker skips the client,
+	// after which it's half-open and lets calls through again.
+	OpenTimeout time.Duration
+
+	// Successes is the number of consecutive successful calls which close a
+	// half-open breaker, and the number of calls which it lets through at a time.
+	// Any failed call opens it again.
+	Successes int
+}
+
+// BreakerState is the state of a client's circuit breaker.
+type BreakerState int
+
+const (
+	// BreakerClosed lets calls through.
+	BreakerClosed BreakerState = iota
+
+	// BreakerOpen skips the client.
+	BreakerOpen
+
+	// BreakerHalfOpen lets calls through to test whether the client recovered.
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// BreakerStatus is the state of the circuit breaker of a client.
+type BreakerStatus struct {
+	Client beacon.Client
+	State  BreakerState
+
+	// Failures is the number of consecutive failed calls.
+	Failures int
+
+	// OpenedAt is the time at which the breaker last opened.
+	OpenedAt time.Time
+}
+
+func (s *BreakerStatus) String() string {
+	if s.State == BreakerOpen {
+		return fmt.Sprintf("%s: %s since %s", s.Client.Address(), s.State, s.OpenedAt.Format(time.RFC3339))
+	}
+	return fmt.Sprintf("%s: %s (%d failures)", s.Client.Address(), s.State, s.Failures)
+}
+
+// breakers holds the circuit breakers of the clients in the pool by
+// Client.Address(), which are shared between copies of Client.
+type breakers struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	clients map[string]*breaker
+}
+
+type breaker struct {
+	state     BreakerState
+	failures  int
+	successes int
+	openedAt  time.Time
+
+	// probes is the number of calls in flight which
+	// were let through by the half-open breaker.
+	probes int
+}
+
+// stateAt returns the state of the breaker at the given time, which is
+// half-open if it's been open for longer than the given OpenTimeout.
+func (br *breaker) stateAt(config CircuitBreaker, now time.Time) BreakerState {
+	if br.state == BreakerOpen && now.Sub(br.openedAt) >= config.OpenTimeout {
+		return BreakerHalfOpen
+	}
+	return br.state
+}
+
+// get returns the breaker of the given client, moving it to half-open
+// if it's been open for longer than the given CircuitBreaker's OpenTimeout.
+func (b *breakers) get(config CircuitBreaker, client beacon.Client) *breaker {
+	br, ok := b.clients[client.Address()]
+	if !ok {
+		br = &breaker{}
+		b.clients[client.Address()] = br
+	}
+	if br.state == BreakerOpen && br.stateAt(config, b.now()) == BreakerHalfOpen {
+		br.state = BreakerHalfOpen
+		br.successes = 0
+	}
+	return br
+}
+
+// allow returns true if the breaker of the given client lets a call through.
+// Half-open breakers let through up to the given CircuitBreaker's Successes calls
+// at a time, so calls which are let through must call release once they're done.
+func (b *breakers) allow(config CircuitBreaker, client beacon.Client) (allowed bool, release func()) {
+	release = func() {}
+	if config.Failures <= 0 {
+		return true, release
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	br := b.get(config, client)
+	switch br.state {
+	case BreakerOpen:
+		return false, release
+	case BreakerHalfOpen:
+		if br.probes >= max(config.Successes, 1) {
+			return false, release
+		}
+		br.probes++
+		return true, func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			br.probes--
+		}
+	}
+	return true, release
+}
+
+// observe updates the breaker of the client of the given CallLog with its outcome.
+// Calls which were cancelled, such as after another client succeeded, and
+// errors which don't indicate a faulty client are not failures.
+func (b *breakers) observe(config CircuitBreaker, log *CallLog) {
+	if config.Failures <= 0 || errors.Is(log.Err, context.Canceled) {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	br := b.get(config, log.Client)
+	if log.Err == nil || errors.Is(log.Err, beacon.ErrBlockNotFound) {
+		br.failures = 0
+		if br.state == BreakerHalfOpen {
+			br.successes++
+			if br.successes >= config.Successes {
+				br.state = BreakerClosed
+			}
+		}
+		return
+	}
+
+	br.failures++
+	if br.state == BreakerHalfOpen || br.failures >= config.Failures {
+		br.state = BreakerOpen
+		br.openedAt = b.now()
+	}
+}
+
+// Breakers returns the state of the circuit breaker of each client in the pool,
+// according to the CircuitBreaker of the current Scope. It doesn't change the
+// state of the breakers, which only move to half-open once a call is made.
+func (c *Client) Breakers() []BreakerStatus {
+	clients := c.Clients()
+	statuses := make([]BreakerStatus, len(clients))
+
+	c.breakers.mu.Lock()
+	defer c.breakers.mu.Unlock()
+	now := c.breakers.now()
+	for i, client := range clients {
+		statuses[i] = BreakerStatus{Client: client}
+		if br, ok := c.breakers.clients[client.Address()]; ok {
+			statuses[i].State = br.stateAt(c.scope.CircuitBreaker, now)
+			statuses[i].Failures = br.failures
+			statuses[i].OpenedAt = br.openedAt
+		}
+	}
+	return statuses
+}
@@ -0,0 +1,137 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/ssvlabs/beacon-kit"
+	"github.com/ssvlabs/beacon-kit/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	nodes := make([]*testNode, 2)
+	clients := make([]beacon.Client, len(nodes))
+	for i := range nodes {
+		nodes[i] = &testNode{active: true, synced: true}
+		clients[i] = CreateTestClient(TestClientKnobs{Address: fmt.Sprintf("http://node-%d", i), Node: nodes[i]})
+	}
+	pool := New(clients, SelectAll(), FirstSuccess(false), RetryEveryLimit(0, 0),
+		CircuitBreaker{Failures: 2, OpenTimeout: time.Minute, Successes: 2})
+	now := time.Now()
+	pool.breakers.now = func() time.Time { return now }
+
+	call := func() {
+		_, err := pool.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "32"})
+		require.NoError(t, err)
+	}
+	requireStates := func(states ...BreakerState) {
+		t.Helper()
+		for i, status := range pool.Breakers() {
+			require.Equal(t, states[i], status.State, status.String())
+		}
+	}
+	requireCalls := func(calls ...int) {
+		t.Helper()
+		for i, client := range clients {
+			client.(*mocks.Client).AssertNumberOfCalls(t, "BeaconBlockHeader", calls[i])
+		}
+	}
+
+	// A success resets the consecutive failures.
+	nodes[1].callErr = errors.New("timeout")
+	call()
+	require.Equal(t, 1, pool.Breakers()[1].Failures)
+	nodes[1].callErr = nil
+	call()
+	require.Zero(t, pool.Breakers()[1].Failures)
+	requireStates(BreakerClosed, BreakerClosed)
+
+	// Consecutive failures open the breaker, which skips the client.
+	nodes[1].callErr = errors.New("timeout")
+	call()
+	call()
+	requireStates(BreakerClosed, BreakerOpen)
+	require.Equal(t, now, pool.Breakers()[1].OpenedAt)
+	call()
+	requireCalls(5, 4)
+
+	// After the timeout, a failure in half-open reopens the breaker.
+	now = now.Add(time.Minute)
+	requireStates(BreakerClosed, BreakerHalfOpen)
+	call()
+	requireStates(BreakerClosed, BreakerOpen)
+	requireCalls(6, 5)
+
+	// Successes in half-open close the breaker.
+	now = now.Add(time.Minute)
+	nodes[1].callErr = nil
+	call()
+	requireStates(BreakerClosed, BreakerHalfOpen)
+	call()
+	requireStates(BreakerClosed, BreakerClosed)
+	requireCalls(8, 7)
+
+	// Without a CircuitBreaker, every client is called.
+	nodes[1].callErr = errors.New("timeout")
+	unbroken := pool.With(CircuitBreaker{})
+	for range 3 {
+		_, err := unbroken.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "32"})
+		require.NoError(t, err)
+	}
+	requireCalls(11, 10)
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	ctx := context.Background()
+	clients := []beacon.Client{
+		CreateTestClient(TestClientKnobs{Address: "http://node-0", Node: &testNode{}}),
+		CreateTestClient(TestClientKnobs{Address: "http://node-1", Node: &testNode{}}),
+	}
+	pool := New(clients, SelectAll(), FirstSuccess(false), RetryEveryLimit(0, 0),
+		CircuitBreaker{Failures: 1, OpenTimeout: time.Minute, Successes: 1})
+	now := time.Now()
+	pool.breakers.now = func() time.Time { return now }
+
+	require.NoError(t, pool.Call(ctx, func(ctx context.Context, client beacon.Client) error {
+		if client.Address() == "http://node-1" {
+			return errors.New("timeout")
+		}
+		return nil
+	}))
+	require.Equal(t, BreakerOpen, pool.Breakers()[1].State)
+
+	// Breakers reports the half-open state without changing it.
+	now = now.Add(time.Minute)
+	require.Equal(t, BreakerHalfOpen, pool.Breakers()[1].State)
+	require.Equal(t, BreakerOpen, pool.breakers.clients["http://node-1"].state)
+
+	// A half-open breaker lets through up to Successes calls at a time.
+	probing, unblock := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- pool.Call(ctx, func(ctx context.Context, client beacon.Client) error {
+			if client.Address() == "http://node-1" {
+				close(probing)
+				<-unblock
+			}
+			return nil
+		})
+	}()
+	<-probing
+	var called []string
+	require.NoError(t, pool.Call(ctx, func(ctx context.Context, client beacon.Client) error {
+		called = append(called, client.Address())
+		return nil
+	}))
+	require.Equal(t, []string{"http://node-0"}, called)
+
+	close(unblock)
+	require.NoError(t, <-done)
+	require.Equal(t, BreakerClosed, pool.Breakers()[1].State)
+}
@@ -2,6 +2,7 @@ package pool
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -19,6 +20,11 @@ type CallLog struct {
 	Start       time.Time
 	End         time.Time
 	Err         error
+
+	// Response is the response of a successful attempt, if the call compares
+	// responses (see Quorum and Divergence).
+	Response any
+	commit   func()
 }
 
 func (l *CallLog) String() string {
@@ -76,6 +82,16 @@ type call struct {
 	scope    Scope
 	clients  []beacon.Client
 	callFunc callFunc
+
+	// observe, if set, receives the CallLog of every attempt.
+	observe func(method string, log *CallLog)
+
+	// hedgeDelay, if positive, enables hedging (see Hedge).
+	hedgeDelay time.Duration
+
+	// takeRetry, if set, is called before each retry,
+	// which is skipped if it returns false (see RetryBudget).
+	takeRetry func() bool
 }
 
 func newCall(scope Scope, clients []beacon.Client, callFunc callFunc) *call {
@@ -87,13 +103,23 @@ func newCall(scope Scope, clients []beacon.Client, callFunc callFunc) *call {
 }
 
 func (c *call) Do(ctx context.Context) error {
+	if deadline := time.Time(c.scope.Deadline); !deadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	// Populate `jobs` with the indices of the clients.
+	// Populate `jobs` with the indices of the clients. When hedging, only the
+	// first client is called, and receiveCalls releases the others.
 	jobs := make(chan int, len(c.clients)*2)
 	defer close(jobs)
-	for clientIndex := range c.clients {
+	released := len(c.clients)
+	if c.hedgeDelay > 0 {
+		released = 1
+	}
+	for clientIndex := range released {
 		jobs <- clientIndex
 	}
 
@@ -103,7 +129,7 @@ func (c *call) Do(ctx context.Context) error {
 		calls   = make(chan *CallLog, len(c.clients)*2)
 		callers = int(c.scope.Concurrency)
 	)
-	if callers > len(c.clients) {
+	if callers > len(c.clients) || c.hedgeDelay > 0 {
 		callers = len(c.clients)
 	}
 	defer close(calls)
@@ -117,7 +143,7 @@ func (c *call) Do(ctx context.Context) error {
 
 	// Receive from `calls` until jobs are done or until
 	// the first success (if scope.FirstSuccess is true)
-	err := c.receiveCalls(ctx, jobs, calls)
+	err := c.receiveCalls(ctx, jobs, released, calls)
 
 	// Wait for any remaining workers.
 	cancel()
@@ -130,14 +156,21 @@ func (c *call) caller(ctx context.Context, jobs <-chan int, errors chan<- *CallL
 	for {
 		select {
 		case clientIndex := <-jobs:
+			var response callResponse
+			callCtx := ctx
+			if c.scope.Quorum > 0 || c.scope.Divergence != nil {
+				callCtx = context.WithValue(ctx, responseCtxKey{}, &response)
+			}
 			start := time.Now()
-			err := c.callWithTimeout(ctx, c.clients[clientIndex])
+			err := c.callWithTimeout(callCtx, c.clients[clientIndex])
 			errors <- &CallLog{
 				ClientIndex: clientIndex,
 				Client:      c.clients[clientIndex],
 				Start:       start,
 				End:         time.Now(),
 				Err:         err,
+				Response:    response.response,
+				commit:      response.commit,
 			}
 		case <-ctx.Done():
 			return
@@ -152,12 +185,40 @@ func (c *call) callWithTimeout(ctx context.Context, client beacon.Client) error
 	return c.callFunc(ctx, client)
 }
 
-func (c *call) receiveCalls(ctx context.Context, jobs chan<- int, logs <-chan *CallLog) error {
+func (c *call) receiveCalls(ctx context.Context, jobs chan<- int, released int, logs <-chan *CallLog) error {
 	var (
 		trace            CallTrace
 		clientTries      = make([]int, len(c.clients))
 		exhaustedClients int
+		hedge            <-chan time.Time
+		answers          = make([]*CallLog, len(c.clients))
+		agreements       [][]*CallLog
+		responses        []*CallLog
+		retries          = make(chan int)
+		retryTimers      []*time.Timer
 	)
+	defer func() {
+		c.reportDivergence(ctx, responses)
+		for _, timer := range retryTimers {
+			timer.Stop()
+		}
+	}()
+
+	// When hedging, release the next client after every hedgeDelay,
+	// or as soon as a client fails.
+	releaseNext := func() {}
+	if c.hedgeDelay > 0 {
+		timer := time.NewTimer(c.hedgeDelay)
+		defer timer.Stop()
+		hedge = timer.C
+		releaseNext = func() {
+			if released < len(c.clients) {
+				jobs <- released
+				released++
+				timer.Reset(c.hedgeDelay)
+			}
+		}
+	}
 
 	// TODO: find a way to do this nicely.
 	// defer func() {
@@ -171,13 +232,25 @@ func (c *call) receiveCalls(ctx context.Context, jobs chan<- int, logs <-chan *C
 			logging.FromContext(ctx).Debug(fmt.Sprintf("ClientCall/%s: context cancelled", methodFromContext(ctx)),
 				zap.String("method", methodFromContext(ctx)),
 				zap.Error(ctx.Err()))
+			// Only the Deadline of the scope fails the call, not the caller's.
+			if deadline := time.Time(c.scope.Deadline); !deadline.IsZero() && !time.Now().Before(deadline) &&
+				errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return ctx.Err()
+			}
 			return nil
+		case <-hedge:
+			releaseNext()
+		case clientIndex := <-retries:
+			jobs <- clientIndex
 		case log, ok := <-logs:
 			if !ok {
 				return nil
 			}
 			log.Attempt = clientTries[log.ClientIndex]
 			trace = append(trace, *log)
+			if c.observe != nil {
+				c.observe(methodFromContext(ctx), log)
+			}
 
 			// TODO: don't log like that :D
 			logging.FromContext(ctx).Debug(
@@ -188,23 +261,72 @@ func (c *call) receiveCalls(ctx context.Context, jobs chan<- int, logs <-chan *C
 			)
 
 			if log.Err != nil {
+				// Don't wait for the failed client's retries to hedge.
+				releaseNext()
 				if c.shouldRetryError(log.Err) {
 					delay, retry := c.scope.Retry(clientTries[log.ClientIndex], log.Err)
+					if deadline := time.Time(c.scope.Deadline); !deadline.IsZero() && time.Now().Add(delay).After(deadline) {
+						retry = false
+					}
+					if retry && c.takeRetry != nil && !c.takeRetry() {
+						retry = false
+					}
 					if retry {
+						// Schedule the retry without blocking the other clients' results.
 						clientTries[log.ClientIndex]++
-						time.Sleep(delay)
-						jobs <- log.ClientIndex
+						retryTimers = append(retryTimers, time.AfterFunc(delay, func() {
+							select {
+							case retries <- log.ClientIndex:
+							case <-ctx.Done():
+							}
+						}))
 						continue
 					}
 				}
-			} else if c.scope.FirstSuccess {
-				// Quit on first success.
-				// TODO: we ignore previous errors here.
-				return nil
+			} else {
+				responses = append(responses, log)
+				if c.scope.Quorum > 0 {
+					// Quit once enough clients agree on the response.
+					agreements = agree(agreements, log)
+					for _, agreement := range agreements {
+						if len(agreement) >= int(c.scope.Quorum) {
+							if commit := agreement[0].commit; commit != nil {
+								commit()
+							}
+							return nil
+						}
+					}
+				} else {
+					// Responses which are only recorded to be compared
+					// are committed as they arrive.
+					if log.commit != nil {
+						log.commit()
+					}
+					if c.scope.FirstSuccess || c.hedgeDelay > 0 {
+						// Quit on first success.
+						// TODO: we ignore previous errors here.
+						return nil
+					}
+				}
 			}
 
 			// If we got here, we either succeeded or we're not retrying.
 			exhaustedClients++
+			answers[log.ClientIndex] = log
+
+			// Fail as soon as the remaining clients can't reach the Quorum.
+			if c.scope.Quorum > 0 && !quorumReachable(c.scope.Quorum, agreements, len(c.clients)-exhaustedClients) {
+				if len(agreements) == 0 {
+					return &Error{trace}
+				}
+				disagreement := &DisagreementError{Quorum: c.scope.Quorum}
+				for _, answer := range answers {
+					if answer != nil {
+						disagreement.Answers = append(disagreement.Answers, *answer)
+					}
+				}
+				return disagreement
+			}
 
 			if exhaustedClients == len(c.clients) {
 				if len(trace.Errors()) < len(c.clients) {
@@ -3,9 +3,11 @@ package pool
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/attestantio/go-eth2-client/api"
 	v1 "github.com/attestantio/go-eth2-client/api/v1"
@@ -24,6 +26,11 @@ type state struct {
 	// clientSubscriptions is map of Client.Address() -> subscription.uuid -> Context.Cancel()
 	clientSubscriptions map[string]map[uuid.UUID]func()
 	subscriptionsMu     sync.RWMutex
+
+	health      *health
+	breakers    *breakers
+	latencies   *latencies
+	retryBudget *retryBudget
 }
 
 // Client implements a beacon.Client which replicates calls to
@@ -45,6 +52,20 @@ func New(clients []beacon.Client, options ...interface{}) *Client {
 			clients:              clients,
 			desiredSubscriptions: map[uuid.UUID]subscription{},
 			clientSubscriptions:  map[string]map[uuid.UUID]func(){},
+			health: &health{
+				clients: map[string]*ClientHealth{},
+				calls:   map[string]*callCounts{},
+			},
+			breakers: &breakers{
+				now:     time.Now,
+				clients: map[string]*breaker{},
+			},
+			latencies: &latencies{
+				methods: map[string][]time.Duration{},
+			},
+			retryBudget: &retryBudget{
+				now: time.Now,
+			},
 		},
 		scope: *scope,
 	}
@@ -114,24 +135,89 @@ func (c *Client) With(options ...interface{}) *Client {
 
 // Call calls callFunc for each selected client in the pool
 // with concurrency and retries according to the current Scope.
+// Clients which failed their latest health check (see CheckHealth) or whose
+// circuit breaker is open (see CircuitBreaker) are skipped, unless every client is.
+// Retries are skipped once the RetryBudget is exhausted.
+// The clients selected by a RankedSelector are called from the best to the worst.
 func (c *Client) Call(ctx context.Context, callFunc func(context.Context, beacon.Client) error) error {
-	clients := c.Clients()
+	clients, release := c.availableClients()
+	defer release()
 
-	selectFunc := c.scope.Select(len(clients))
 	selectedClients := make([]beacon.Client, 0, len(clients))
-	for clientIndex, client := range clients {
-		if selectFunc(clientIndex, client) {
-			selectedClients = append(selectedClients, client)
+	if selector, ok := c.scope.Selector.(RankedSelector); ok {
+		for _, clientIndex := range selector.SelectRanked(methodFromContext(ctx), clients) {
+			selectedClients = append(selectedClients, clients[clientIndex])
+		}
+	} else {
+		var selectFunc func(int, beacon.Client) bool
+		if c.scope.Selector != nil {
+			selectFunc = c.scope.Selector.Select(methodFromContext(ctx), clients)
+		} else {
+			selectFunc = c.scope.Select(len(clients))
+		}
+		for clientIndex, client := range clients {
+			if selectFunc(clientIndex, client) {
+				selectedClients = append(selectedClients, client)
+			}
 		}
 	}
 	if len(selectedClients) == 0 {
 		return errors.New("no clients selected")
 	}
+	if c.scope.Quorum > 0 && int(c.scope.Quorum) > len(selectedClients) {
+		return fmt.Errorf("%w: quorum of %d with %d selected clients",
+			ErrQuorumUnreachable, c.scope.Quorum, len(selectedClients))
+	}
 
 	call := newCall(c.scope, selectedClients, callFunc)
+	call.observe = c.observe
+	if c.scope.Quorum == 0 {
+		// Quorum takes precedence over Hedge.
+		call.hedgeDelay = c.hedgeDelay(methodFromContext(ctx))
+	}
+	call.takeRetry = func() bool {
+		return c.retryBudget.take(c.scope.RetryBudget)
+	}
 	return call.Do(ctx)
 }
 
+// observe receives the CallLog of every attempt of every call.
+func (c *Client) observe(method string, log *CallLog) {
+	c.health.observe(log)
+	c.breakers.observe(c.scope.CircuitBreaker, log)
+	c.latencies.observe(method, log)
+	if c.scope.Selector != nil {
+		c.scope.Selector.Observe(method, *log)
+	}
+}
+
+// availableClients returns the clients in the pool which are healthy and whose
+// circuit breaker lets calls through, or all of them if none is. The returned
+// release function must be called once the call to the clients is done.
+func (c *Client) availableClients() ([]beacon.Client, func()) {
+	clients := c.Clients()
+	available := make([]beacon.Client, 0, len(clients))
+	var releases []func()
+	for _, client := range clients {
+		if !c.health.healthy(client) {
+			continue
+		}
+		if allowed, release := c.breakers.allow(c.scope.CircuitBreaker, client); allowed {
+			available = append(available, client)
+			releases = append(releases, release)
+		}
+	}
+	release := func() {
+		for _, release := range releases {
+			release()
+		}
+	}
+	if len(available) == 0 {
+		return clients, release
+	}
+	return available, release
+}
+
 type subscription struct {
 	topics  []string
 	handler EventHandlerFunc
@@ -154,15 +240,38 @@ func (c *Client) Events(ctx context.Context, opts *api.EventsOpts) error {
 }
 
 func (c *Client) EventsWithClient(ctx context.Context, topics []string, handler EventHandlerFunc) error {
+	_, err := c.subscribe(ctx, topics, handler)
+	return err
+}
+
+// subscribe subscribes every client in the pool to the given topics, like
+// EventsWithClient, and returns the UUID of the subscription (see unsubscribe).
+func (c *Client) subscribe(ctx context.Context, topics []string, handler EventHandlerFunc) (uuid.UUID, error) {
+	subscriptionUUID := uuid.New()
 	func() {
 		c.subscriptionsMu.Lock()
 		defer c.subscriptionsMu.Unlock()
-		c.desiredSubscriptions[uuid.New()] = subscription{
+		c.desiredSubscriptions[subscriptionUUID] = subscription{
 			topics:  topics,
 			handler: handler,
 		}
 	}()
-	return c.updateSubscriptions(ctx)
+	return subscriptionUUID, c.updateSubscriptions(ctx)
+}
+
+// unsubscribe removes the subscription with the given UUID,
+// closing its event streams of every client.
+func (c *Client) unsubscribe(subscriptionUUID uuid.UUID) {
+	c.subscriptionsMu.Lock()
+	defer c.subscriptionsMu.Unlock()
+
+	delete(c.desiredSubscriptions, subscriptionUUID)
+	for _, clientSubscriptions := range c.clientSubscriptions {
+		if cancel, ok := clientSubscriptions[subscriptionUUID]; ok {
+			cancel()
+			delete(clientSubscriptions, subscriptionUUID)
+		}
+	}
 }
 
 func (c *Client) updateSubscriptions(ctx context.Context) error {
@@ -3,6 +3,7 @@ package pool
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"math/rand"
 	"os"
 	"testing"
@@ -88,7 +89,7 @@ func TestPoolFaultTolerance(t *testing.T) {
 	poolBlock, err := pool.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "32"})
 	require.NoError(t, err)
 
-	clientBlock, err := CreateTestClient(0, 0, 0).BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "32"})
+	clientBlock, err := CreateTestClient(TestClientKnobs{}).BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "32"})
 	require.NoError(t, err)
 	require.Equal(t, clientBlock, poolBlock, "pool should return the same data as the client")
 }
@@ -118,7 +119,11 @@ func CreateTestPool(t *testing.T, knobs TestPoolKnobs, options ...interface{}) *
 		if knobs.ErrorRate*float64(knobs.NumClients) > float64(i) {
 			errorRate = 1.0
 		}
-		client := CreateTestClient(knobs.MinSleep, knobs.MaxSleep, errorRate)
+		client := CreateTestClient(TestClientKnobs{
+			MinSleep:  knobs.MinSleep,
+			MaxSleep:  knobs.MaxSleep,
+			ErrorRate: errorRate,
+		})
 		pool.Mocks[i] = client
 		clients[i] = client
 	}
@@ -133,21 +138,170 @@ func CreateTestPool(t *testing.T, knobs TestPoolKnobs, options ...interface{}) *
 	return pool
 }
 
-func CreateTestClient(minSleep, maxSleep time.Duration, errorRate float64) *mocks.Client {
+// TestClientKnobs configures a client created by CreateTestClient.
+type TestClientKnobs struct {
+	// Address defaults to "http://mock".
+	Address string
+
+	// Calls take a random duration between MinSleep and MaxSleep, unless their
+	// context is done first, and fail with a probability of ErrorRate, or with Err.
+	MinSleep  time.Duration
+	MaxSleep  time.Duration
+	ErrorRate float64
+	Err       error
+
+	// Hook, if set, is called by every call before it answers, and fails it with its error.
+	Hook func(ctx context.Context) error
+
+	// Node, if set, is the state of the node behind the client.
+	Node *testNode
+
+	// Slot is the slot of the AttestationData responses.
+	Slot phase0.Slot
+	// ConsensusValue is the consensus value of the Proposal responses.
+	ConsensusValue int64
+	Spec           map[string]interface{}
+	Genesis        *apiv1.Genesis
+}
+
+// testNode is the state of a node, which may be changed while it's called.
+type testNode struct {
+	active, synced bool
+	head           phase0.Slot
+
+	// headErr fails the head block header of the health check,
+	// and callErr fails the block headers of calls.
+	headErr, callErr error
+}
+
+func attestationData(slot phase0.Slot) *phase0.AttestationData {
+	return &phase0.AttestationData{
+		Slot:   slot,
+		Source: &phase0.Checkpoint{},
+		Target: &phase0.Checkpoint{},
+	}
+}
+
+func CreateTestClient(knobs TestClientKnobs) *mocks.Client {
+	if knobs.Address == "" {
+		knobs.Address = "http://mock"
+	}
+	node := knobs.Node
+	if node == nil {
+		node = &testNode{active: true, synced: true}
+	}
+	call := func(ctx context.Context) error {
+		sleep := knobs.MinSleep
+		if knobs.MaxSleep > knobs.MinSleep {
+			sleep += time.Duration(rand.Intn(int(knobs.MaxSleep - knobs.MinSleep + 1)))
+		}
+		if sleep > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(sleep):
+			}
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if knobs.Hook != nil {
+			if err := knobs.Hook(ctx); err != nil {
+				return err
+			}
+		}
+		if knobs.Err != nil {
+			return knobs.Err
+		}
+		if rand.Float64() < knobs.ErrorRate {
+			return fmt.Errorf("error")
+		}
+		return nil
+	}
+
 	client := &mocks.Client{}
-	client.On("Address").Maybe().Return("http://mock")
+	client.On("Address").Maybe().Return(knobs.Address)
+	client.On("IsActive").Maybe().Return(func() bool { return node.active })
+	client.On("IsSynced").Maybe().Return(func() bool { return node.synced })
 	client.On("BeaconBlockHeader", mock.Anything, mock.AnythingOfType("*api.BeaconBlockHeaderOpts")).
 		Maybe().
-		Return(func(ctx context.Context, opts *api.BeaconBlockHeaderOpts) *api.Response[*apiv1.BeaconBlockHeader] {
-			if maxSleep > 0 {
-				time.Sleep(minSleep + time.Duration(rand.Intn(int(maxSleep-minSleep+1))))
+		Return(func(ctx context.Context, opts *api.BeaconBlockHeaderOpts) (*api.Response[*apiv1.BeaconBlockHeader], error) {
+			if err := call(ctx); err != nil {
+				return nil, err
+			}
+			if opts.Block == "head" && node.headErr != nil {
+				return nil, node.headErr
 			}
-			return &api.Response[*apiv1.BeaconBlockHeader]{Data: &apiv1.BeaconBlockHeader{Root: phase0.Root{}}}
-		}, func(ctx context.Context, opts *api.BeaconBlockHeaderOpts) error {
-			if rand.Float64() <= errorRate {
-				return fmt.Errorf("error")
+			if opts.Block != "head" && node.callErr != nil {
+				return nil, node.callErr
 			}
-			return nil
+			return &api.Response[*apiv1.BeaconBlockHeader]{Data: &apiv1.BeaconBlockHeader{
+				Header: &phase0.SignedBeaconBlockHeader{
+					Message: &phase0.BeaconBlockHeader{Slot: node.head},
+				},
+			}}, nil
+		})
+	client.On("AttestationData", mock.Anything, mock.AnythingOfType("*api.AttestationDataOpts")).
+		Maybe().
+		Return(func(ctx context.Context, opts *api.AttestationDataOpts) (*api.Response[*phase0.AttestationData], error) {
+			if err := call(ctx); err != nil {
+				return nil, err
+			}
+			return &api.Response[*phase0.AttestationData]{Data: attestationData(knobs.Slot)}, nil
+		})
+	client.On("Proposal", mock.Anything, mock.AnythingOfType("*api.ProposalOpts")).
+		Maybe().
+		Return(func(ctx context.Context, opts *api.ProposalOpts) (*api.Response[*api.VersionedProposal], error) {
+			if err := call(ctx); err != nil {
+				return nil, err
+			}
+			return &api.Response[*api.VersionedProposal]{
+				Data: &api.VersionedProposal{ConsensusValue: big.NewInt(knobs.ConsensusValue)},
+			}, nil
+		})
+	client.On("Spec", mock.Anything, mock.Anything).
+		Maybe().
+		Return(func(ctx context.Context, opts *api.SpecOpts) (*api.Response[map[string]interface{}], error) {
+			if err := call(ctx); err != nil {
+				return nil, err
+			}
+			return &api.Response[map[string]interface{}]{Data: knobs.Spec}, nil
+		})
+	client.On("Genesis", mock.Anything, mock.Anything).
+		Maybe().
+		Return(func(ctx context.Context, opts *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error) {
+			if err := call(ctx); err != nil {
+				return nil, err
+			}
+			return &api.Response[*apiv1.Genesis]{Data: knobs.Genesis}, nil
 		})
 	return client
 }
+
+func TestPoolDeadline(t *testing.T) {
+	ctx := context.Background()
+
+	// Retries which would start after the deadline are skipped.
+	knobs := TestPoolKnobs{NumClients: 1, ErrorRate: 1}
+	pool := CreateTestPool(t, knobs, RetryEvery(100*time.Millisecond))
+	_, err := pool.With(Deadline(time.Now().Add(150*time.Millisecond))).
+		BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "32"})
+	errStruct, ok := err.(*Error)
+	require.True(t, ok, "error should be a CallTrace")
+	require.Len(t, errStruct.Trace, 2)
+
+	// Calls which are still running at the deadline fail.
+	knobs = TestPoolKnobs{NumClients: 1, MinSleep: 200 * time.Millisecond, MaxSleep: 200 * time.Millisecond}
+	pool = CreateTestPool(t, knobs, Deadline(time.Now().Add(50*time.Millisecond)))
+	_, err = pool.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "32"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The zero deadline is ignored.
+	_, err = pool.With(Deadline{}).BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "32"})
+	require.NoError(t, err)
+
+	// The caller's own deadline doesn't fail the call.
+	callerCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	_, err = pool.With(Deadline{}).BeaconBlockHeader(callerCtx, &api.BeaconBlockHeaderOpts{Block: "32"})
+	require.NoError(t, err)
+}
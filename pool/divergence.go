@@ -0,0 +1,89 @@
+package pool
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/ssvlabs/beacon-kit"
+)
+
+// Divergence is called after each call in which clients returned different
+// responses, such as with FirstSuccess(false) and SelectAll, to detect clients
+// which disagree even when the call doesn't require a Quorum. It's called
+// synchronously before the call returns, so it shouldn't block.
+// A nil Divergence disables the comparison of responses.
+type Divergence func(context.Context, DivergenceReport)
+
+// DivergenceReport describes a call in which clients returned different responses.
+type DivergenceReport struct {
+	// Method is the called method, such as "AttestationData".
+	Method string
+
+	// Args are the arguments of the call, excluding the context.
+	Args []any
+
+	// Hashes are the hashes of the response of each client which succeeded.
+	Hashes []ResponseHash
+}
+
+// ResponseHash is the hash of the response of a client.
+type ResponseHash struct {
+	Client beacon.Client
+
+	// Hash is the SSZ hash tree root of the response's Data if it has one,
+	// or the SHA-256 of its JSON encoding otherwise.
+	Hash [32]byte
+}
+
+func (r DivergenceReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "DivergenceReport(%s):", r.Method)
+	for _, h := range r.Hashes {
+		fmt.Fprintf(&b, "\n\t%s -> %s", h.Client.Address(), hex.EncodeToString(h.Hash[:]))
+	}
+	return b.String()
+}
+
+// reportDivergence calls the Divergence of the call's scope
+// if the given successful CallLogs have different responses.
+func (c *call) reportDivergence(ctx context.Context, responses []*CallLog) {
+	if c.scope.Divergence == nil || len(responses) < 2 {
+		return
+	}
+	report := DivergenceReport{
+		Method: methodFromContext(ctx),
+		Args:   argsFromContext(ctx),
+		Hashes: make([]ResponseHash, len(responses)),
+	}
+	diverged := false
+	for i, log := range responses {
+		report.Hashes[i] = ResponseHash{Client: log.Client, Hash: responseHash(log.Response)}
+		if report.Hashes[i].Hash != report.Hashes[0].Hash {
+			diverged = true
+		}
+	}
+	if diverged {
+		c.scope.Divergence(ctx, report)
+	}
+}
+
+// responseHash returns the SSZ hash tree root of the Data of the given response
+// if it has one, or the SHA-256 of its JSON encoding otherwise.
+func responseHash(response any) [32]byte {
+	data := responseData(response)
+	if data, ok := data.(ssz.HashRoot); ok {
+		if root, err := data.HashTreeRoot(); err == nil {
+			return root
+		}
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		encoded = fmt.Appendf(nil, "%#v", data)
+	}
+	return sha256.Sum256(encoded)
+}
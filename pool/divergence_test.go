@@ -0,0 +1,90 @@
+package pool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/beacon-kit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDivergence(t *testing.T) {
+	ctx := context.Background()
+	opts := &api.AttestationDataOpts{Slot: 1}
+
+	var reports []DivergenceReport
+	divergence := Divergence(func(ctx context.Context, report DivergenceReport) {
+		reports = append(reports, report)
+	})
+
+	// Clients which agree aren't reported.
+	pool := New([]beacon.Client{
+		CreateTestClient(TestClientKnobs{Address: "http://a", Slot: 1}),
+		CreateTestClient(TestClientKnobs{Address: "http://b", Slot: 1}),
+	}, SelectAll(), FirstSuccess(false), RetryEveryLimit(0, 0), divergence)
+	resp, err := pool.AttestationData(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, phase0.Slot(1), resp.Data.Slot)
+	require.Empty(t, reports)
+
+	// Clients which disagree are reported.
+	pool = New([]beacon.Client{
+		CreateTestClient(TestClientKnobs{Address: "http://a", Slot: 1}),
+		CreateTestClient(TestClientKnobs{Address: "http://b", Slot: 2}),
+		CreateTestClient(TestClientKnobs{Address: "http://c", Slot: 1}),
+	}, SelectAll(), FirstSuccess(false), RetryEveryLimit(0, 0), divergence)
+	resp, err = pool.AttestationData(ctx, opts)
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Len(t, reports, 1)
+
+	report := reports[0]
+	require.Equal(t, "AttestationData", report.Method)
+	require.Equal(t, []any{opts}, report.Args)
+	require.Len(t, report.Hashes, 3)
+	root1, err := attestationData(1).HashTreeRoot()
+	require.NoError(t, err)
+	root2, err := attestationData(2).HashTreeRoot()
+	require.NoError(t, err)
+	hashes := map[string][32]byte{}
+	for _, h := range report.Hashes {
+		hashes[h.Client.Address()] = h.Hash
+	}
+	require.Equal(t, map[string][32]byte{"http://a": root1, "http://b": root2, "http://c": root1}, hashes)
+
+	// Calls which stop at the first success have nothing to compare.
+	reports = nil
+	_, err = pool.With(FirstSuccess(true), SelectAll()).AttestationData(ctx, opts)
+	require.NoError(t, err)
+	require.Empty(t, reports)
+}
+
+func TestDivergenceProposal(t *testing.T) {
+	var reports []DivergenceReport
+	pool := New([]beacon.Client{
+		CreateTestClient(TestClientKnobs{Address: "http://a", ConsensusValue: 1}),
+		CreateTestClient(TestClientKnobs{Address: "http://b", ConsensusValue: 2}),
+	}, SelectAll(), FirstSuccess(false), RetryEveryLimit(0, 0), Divergence(func(ctx context.Context, report DivergenceReport) {
+		reports = append(reports, report)
+	}))
+
+	opts := &api.ProposalOpts{Slot: 123}
+	_, err := pool.Proposal(context.Background(), opts)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	require.Equal(t, "Proposal", reports[0].Method)
+	require.Equal(t, []any{opts}, reports[0].Args)
+	require.NotEqual(t, reports[0].Hashes[0].Hash, reports[0].Hashes[1].Hash)
+}
+
+func TestResponseHash(t *testing.T) {
+	balances := func(gwei phase0.Gwei) *api.Response[map[phase0.ValidatorIndex]phase0.Gwei] {
+		return &api.Response[map[phase0.ValidatorIndex]phase0.Gwei]{
+			Data: map[phase0.ValidatorIndex]phase0.Gwei{1: 32, 2: gwei},
+		}
+	}
+	require.Equal(t, responseHash(balances(32)), responseHash(balances(32)))
+	require.NotEqual(t, responseHash(balances(32)), responseHash(balances(31)))
+}
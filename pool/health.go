@@ -0,0 +1,263 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/beacon-kit"
+)
+
+var (
+	ErrClientInactive  = errors.New("client is inactive")
+	ErrClientSyncing   = errors.New("client is syncing")
+	ErrClientHeadLag   = errors.New("client head lags behind")
+	ErrClientErrorRate = errors.New("client error rate is too high")
+)
+
+// HealthCheck configures the health checks of the clients in the pool.
+// Zero fields are taken from DefaultHealthCheck.
+type HealthCheck struct {
+	// Interval is the interval between health checks.
+	Interval time.Duration
+
+	// MaxHeadLag is the number of slots by which a client's head may lag
+	// behind the best head in the pool.
+	MaxHeadLag phase0.Slot
+
+	// MaxErrorRate is the rate of failed calls, between 0 and 1, which a client
+	// may have since the previous health check.
+	MaxErrorRate float64
+
+	// MinCalls is the number of calls since the previous health check from
+	// which MaxErrorRate applies, to ignore the error rate of idle clients.
+	// Calls are only counted once the first health check ran.
+	MinCalls int
+}
+
+// DefaultHealthCheck returns the default HealthCheck.
+func DefaultHealthCheck() HealthCheck {
+	return HealthCheck{
+		Interval:     time.Second * 12,
+		MaxHeadLag:   2,
+		MaxErrorRate: 0.5,
+		MinCalls:     10,
+	}
+}
+
+// withDefaults returns the HealthCheck with its zero fields
+// taken from DefaultHealthCheck.
+func (h HealthCheck) withDefaults() HealthCheck {
+	defaults := DefaultHealthCheck()
+	if h.Interval <= 0 {
+		h.Interval = defaults.Interval
+	}
+	if h.MaxHeadLag == 0 {
+		h.MaxHeadLag = defaults.MaxHeadLag
+	}
+	if h.MaxErrorRate <= 0 {
+		h.MaxErrorRate = defaults.MaxErrorRate
+	}
+	if h.MinCalls <= 0 {
+		h.MinCalls = defaults.MinCalls
+	}
+	return h
+}
+
+// ClientHealth is the result of the latest health check of a client.
+type ClientHealth struct {
+	Client beacon.Client
+
+	// HeadSlot is the slot of the client's head block header.
+	HeadSlot phase0.Slot
+
+	// HeadLag is the number of slots by which HeadSlot lags
+	// behind the best head in the pool.
+	HeadLag phase0.Slot
+
+	// Calls and Errors are the number of calls and failed calls
+	// in the interval before the health check.
+	Calls  int
+	Errors int
+
+	// CheckedAt is the time of the health check.
+	CheckedAt time.Time
+
+	// Err is set if the client is unhealthy, such as ErrClientSyncing.
+	Err error
+}
+
+// Healthy returns true if the client is healthy.
+func (h *ClientHealth) Healthy() bool {
+	return h.Err == nil
+}
+
+func (h *ClientHealth) String() string {
+	if h.Err != nil {
+		return fmt.Sprintf("⨉ %s -> %s", h.Client.Address(), h.Err)
+	}
+	return fmt.Sprintf("✓ %s (head %d, lag %d, %d/%d errors)",
+		h.Client.Address(), h.HeadSlot, h.HeadLag, h.Errors, h.Calls)
+}
+
+// HealthReport is the result of CheckHealth, with a ClientHealth
+// for each client in the pool.
+type HealthReport []ClientHealth
+
+func (r HealthReport) String() string {
+	if len(r) == 0 {
+		return "HealthReport{}"
+	}
+	var b strings.Builder
+	b.WriteString("HealthReport:")
+	for i := range r {
+		b.WriteString("\n\t" + r[i].String())
+	}
+	return b.String()
+}
+
+// health holds the health of the clients in the pool by Client.Address(), which is
+// shared between copies of Client. Clients which weren't checked yet are healthy,
+// and calls are only counted once the first health check ran.
+type health struct {
+	mu      sync.RWMutex
+	checked bool
+	clients map[string]*ClientHealth
+	calls   map[string]*callCounts
+}
+
+type callCounts struct {
+	calls, errors int
+}
+
+// observe counts the outcome of a call for the error rate of its client.
+func (h *health) observe(log *CallLog) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.checked {
+		return
+	}
+
+	counts, ok := h.calls[log.Client.Address()]
+	if !ok {
+		counts = &callCounts{}
+		h.calls[log.Client.Address()] = counts
+	}
+	counts.calls++
+	if log.Err != nil {
+		counts.errors++
+	}
+}
+
+// healthy returns true if the given client isn't known to be unhealthy.
+func (h *health) healthy(client beacon.Client) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if !h.checked {
+		return true
+	}
+
+	clientHealth, ok := h.clients[client.Address()]
+	return !ok || clientHealth.Healthy()
+}
+
+// Health returns the result of the latest health check of each client in the pool.
+// Clients which weren't checked yet are healthy.
+func (c *Client) Health() HealthReport {
+	clients := c.Clients()
+	report := make(HealthReport, len(clients))
+
+	c.health.mu.RLock()
+	defer c.health.mu.RUnlock()
+	for i, client := range clients {
+		if clientHealth, ok := c.health.clients[client.Address()]; ok {
+			report[i] = *clientHealth
+		} else {
+			report[i] = ClientHealth{Client: client}
+		}
+	}
+	return report
+}
+
+// CheckHealth probes the syncing status and head of every client in the pool, and
+// marks the clients which fail the given HealthCheck as unhealthy, so that calls
+// skip them until a later health check finds them healthy again.
+func (c *Client) CheckHealth(ctx context.Context, check HealthCheck) HealthReport {
+	check = check.withDefaults()
+	clients := c.Clients()
+	report := make(HealthReport, len(clients))
+
+	var wg sync.WaitGroup
+	for i, client := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report[i] = ClientHealth{Client: client, CheckedAt: time.Now()}
+			switch {
+			case !client.IsActive():
+				report[i].Err = ErrClientInactive
+			case !client.IsSynced():
+				report[i].Err = ErrClientSyncing
+			default:
+				report[i].HeadSlot, report[i].Err = c.headSlot(ctx, client)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var bestHead phase0.Slot
+	for i := range report {
+		if report[i].Err == nil {
+			bestHead = max(bestHead, report[i].HeadSlot)
+		}
+	}
+
+	c.health.mu.Lock()
+	defer c.health.mu.Unlock()
+	for i := range report {
+		h := &report[i]
+		if counts, ok := c.health.calls[h.Client.Address()]; ok {
+			h.Calls, h.Errors = counts.calls, counts.errors
+		}
+		if h.Err != nil {
+			continue
+		}
+		h.HeadLag = bestHead - h.HeadSlot
+		if h.HeadLag > check.MaxHeadLag {
+			h.Err = fmt.Errorf("%w: head %d is %d slots behind", ErrClientHeadLag, h.HeadSlot, h.HeadLag)
+		} else if h.Calls > 0 && h.Calls >= check.MinCalls &&
+			float64(h.Errors)/float64(h.Calls) > check.MaxErrorRate {
+			h.Err = fmt.Errorf("%w: %d of %d calls failed", ErrClientErrorRate, h.Errors, h.Calls)
+		}
+	}
+
+	c.health.checked = true
+	clear(c.health.clients)
+	clear(c.health.calls)
+	for _, clientHealth := range report {
+		c.health.clients[clientHealth.Client.Address()] = &clientHealth
+	}
+	return report
+}
+
+// StartHealthCheck runs CheckHealth at every HealthCheck.Interval in the
+// background until the context is cancelled.
+func (c *Client) StartHealthCheck(ctx context.Context, check HealthCheck) {
+	check = check.withDefaults()
+	go func() {
+		ticker := time.NewTicker(check.Interval)
+		defer ticker.Stop()
+		for {
+			c.CheckHealth(ctx, check)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
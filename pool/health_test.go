@@ -0,0 +1,121 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/beacon-kit"
+	"github.com/ssvlabs/beacon-kit/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckHealth(t *testing.T) {
+	ctx := context.Background()
+	nodes := make([]*testNode, 5)
+	clients := make([]beacon.Client, len(nodes))
+	for i := range nodes {
+		nodes[i] = &testNode{active: true, synced: true, head: 100}
+		clients[i] = CreateTestClient(TestClientKnobs{Address: fmt.Sprintf("http://node-%d", i), Node: nodes[i]})
+	}
+	pool := New(clients, SelectAll(), FirstSuccess(false), RetryEveryLimit(0, 0))
+	check := HealthCheck{MaxHeadLag: 2, MaxErrorRate: 0.5, MinCalls: 2}
+	call := func() {
+		_, err := pool.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "32"})
+		require.NoError(t, err)
+	}
+
+	// Clients are healthy until checked, and calls are counted from the first check.
+	for _, clientHealth := range pool.Health() {
+		require.True(t, clientHealth.Healthy())
+	}
+	call()
+	for _, clientHealth := range pool.CheckHealth(ctx, check) {
+		require.True(t, clientHealth.Healthy())
+		require.Zero(t, clientHealth.Calls)
+	}
+
+	nodes[1].head = 97
+	nodes[2].synced = false
+	nodes[3].active = false
+	nodes[4].callErr = errors.New("internal error")
+	call()
+	call()
+
+	report := pool.CheckHealth(ctx, check)
+	require.Len(t, report, 5)
+	require.True(t, report[0].Healthy(), report.String())
+	require.Equal(t, 2, report[0].Calls)
+	require.Zero(t, report[0].Errors)
+	require.ErrorIs(t, report[1].Err, ErrClientHeadLag)
+	require.Equal(t, phase0.Slot(3), report[1].HeadLag)
+	require.ErrorIs(t, report[2].Err, ErrClientSyncing)
+	require.ErrorIs(t, report[3].Err, ErrClientInactive)
+	require.ErrorIs(t, report[4].Err, ErrClientErrorRate)
+	require.Equal(t, 2, report[4].Errors)
+	require.Equal(t, report, pool.Health())
+
+	// Calls skip unhealthy clients. Health checks fetch
+	// the head of the active and synced clients.
+	call()
+	for i, calls := range []int{6, 5, 4, 4, 5} {
+		clients[i].(*mocks.Client).AssertNumberOfCalls(t, "BeaconBlockHeader", calls)
+	}
+
+	// Clients are re-admitted once healthy, and the error rate
+	// only counts calls since the previous health check.
+	nodes[1].head = 99
+	nodes[2].synced = true
+	report = pool.CheckHealth(ctx, check)
+	require.True(t, report[1].Healthy(), report.String())
+	require.True(t, report[2].Healthy(), report.String())
+	require.True(t, report[4].Healthy(), report.String())
+	require.False(t, report[3].Healthy())
+
+	// A client whose head can't be fetched is unhealthy.
+	nodes[0].headErr = errors.New("timeout")
+	report = pool.CheckHealth(ctx, check)
+	require.EqualError(t, report[0].Err, "timeout")
+	nodes[0].headErr = nil
+
+	// If every client is unhealthy, calls use all of them.
+	for _, node := range nodes {
+		node.synced = false
+	}
+	pool.CheckHealth(ctx, check)
+	call()
+	clients[3].(*mocks.Client).AssertNumberOfCalls(t, "BeaconBlockHeader", 5)
+}
+
+func TestStartHealthCheck(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node := &testNode{active: true, synced: false, head: 100}
+	pool := New([]beacon.Client{CreateTestClient(TestClientKnobs{Address: "http://node", Node: node})})
+
+	// The zero Interval runs at the default interval.
+	pool.StartHealthCheck(ctx, HealthCheck{})
+	require.Eventually(t, func() bool {
+		return errors.Is(pool.Health()[0].Err, ErrClientSyncing)
+	}, time.Second, time.Millisecond)
+}
+
+func TestHealthCheckDefaults(t *testing.T) {
+	ctx := context.Background()
+	node := &testNode{active: true, synced: true, head: 100, callErr: errors.New("internal error")}
+	pool := New([]beacon.Client{CreateTestClient(TestClientKnobs{Address: "http://node", Node: node})}, RetryEveryLimit(0, 0))
+
+	// Zero fields are taken from DefaultHealthCheck, so a single
+	// failed call is below its MinCalls.
+	check := HealthCheck{Interval: time.Minute}
+	pool.CheckHealth(ctx, check)
+	_, err := pool.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "32"})
+	require.Error(t, err)
+	report := pool.CheckHealth(ctx, check)
+	require.True(t, report[0].Healthy(), report.String())
+	require.Equal(t, 1, report[0].Errors)
+}
@@ -0,0 +1,81 @@
+package pool
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Hedge sends a call to the next selected client whenever the previous clients
+// haven't answered within a delay, or as soon as one fails, even if it's retried,
+// and returns the first successful response, cancelling the others. It implies
+// FirstSuccess, and requires a SelectFunc which selects several clients, such as
+// SelectAll, which are tried in the order of the pool. The zero Hedge disables hedging.
+type Hedge struct {
+	// Delay is the delay before sending the call to the next client.
+	Delay time.Duration
+
+	// Percentile, if between 0 and 1, replaces Delay with the given percentile
+	// of the latency of the recent successful calls to the same method, such as
+	// 0.95 to hedge the slowest 5% of calls. Delay is used until there are
+	// enough calls to estimate the percentile.
+	Percentile float64
+}
+
+const (
+	// hedgeLatencySamples is the number of recent latencies
+	// kept per method for Hedge.Percentile.
+	hedgeLatencySamples = 256
+
+	// hedgeMinLatencySamples is the number of latencies required
+	// to estimate Hedge.Percentile.
+	hedgeMinLatencySamples = 20
+)
+
+// latencies holds the latency of the recent successful calls to each method,
+// which is shared between copies of Client.
+type latencies struct {
+	mu      sync.Mutex
+	methods map[string][]time.Duration
+}
+
+// observe records the latency of the given CallLog if it succeeded.
+func (l *latencies) observe(method string, log *CallLog) {
+	if log.Err != nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	samples := append(l.methods[method], log.End.Sub(log.Start))
+	if len(samples) > hedgeLatencySamples {
+		samples = samples[1:]
+	}
+	l.methods[method] = samples
+}
+
+// percentile returns the given percentile of the latency of the
+// given method, or false if there aren't enough samples.
+func (l *latencies) percentile(method string, p float64) (time.Duration, bool) {
+	l.mu.Lock()
+	samples := slices.Clone(l.methods[method])
+	l.mu.Unlock()
+
+	if len(samples) < hedgeMinLatencySamples {
+		return 0, false
+	}
+	slices.Sort(samples)
+	return samples[int(p*float64(len(samples)-1))], true
+}
+
+// hedgeDelay returns the hedging delay of the current Scope for
+// the given method, or zero if hedging is disabled.
+func (c *Client) hedgeDelay(method string) time.Duration {
+	hedge := c.scope.Hedge
+	if hedge.Percentile > 0 && hedge.Percentile < 1 {
+		if delay, ok := c.latencies.percentile(method, hedge.Percentile); ok {
+			return max(delay, time.Nanosecond)
+		}
+	}
+	return hedge.Delay
+}
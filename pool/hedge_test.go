@@ -0,0 +1,120 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/ssvlabs/beacon-kit"
+	"github.com/ssvlabs/beacon-kit/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func countCalls(client beacon.Client, method string) int {
+	count := 0
+	for _, call := range client.(*mocks.Client).Calls {
+		if call.Method == method {
+			count++
+		}
+	}
+	return count
+}
+
+func TestHedge(t *testing.T) {
+	ctx := context.Background()
+	opts := &api.BeaconBlockHeaderOpts{Block: "1"}
+
+	t.Run("slow client is hedged", func(t *testing.T) {
+		clients := []beacon.Client{
+			CreateTestClient(TestClientKnobs{Address: "http://slow", MinSleep: time.Second}),
+			CreateTestClient(TestClientKnobs{Address: "http://fast", MinSleep: time.Millisecond}),
+		}
+		pool := New(clients, SelectAll(), RetryEveryLimit(0, 0), Hedge{Delay: 10 * time.Millisecond})
+
+		start := time.Now()
+		resp, err := pool.BeaconBlockHeader(ctx, opts)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Less(t, time.Since(start), 500*time.Millisecond)
+		require.Equal(t, 1, countCalls(clients[0], "BeaconBlockHeader"))
+		require.Equal(t, 1, countCalls(clients[1], "BeaconBlockHeader"))
+	})
+
+	t.Run("fast client isn't hedged", func(t *testing.T) {
+		clients := []beacon.Client{
+			CreateTestClient(TestClientKnobs{Address: "http://fast", MinSleep: time.Millisecond}),
+			CreateTestClient(TestClientKnobs{Address: "http://slow", MinSleep: time.Second}),
+		}
+		pool := New(clients, SelectAll(), RetryEveryLimit(0, 0), Hedge{Delay: 100 * time.Millisecond})
+
+		_, err := pool.BeaconBlockHeader(ctx, opts)
+		require.NoError(t, err)
+		require.Equal(t, 1, countCalls(clients[0], "BeaconBlockHeader"))
+		require.Equal(t, 0, countCalls(clients[1], "BeaconBlockHeader"))
+	})
+
+	t.Run("failed client is hedged immediately", func(t *testing.T) {
+		clients := []beacon.Client{
+			CreateTestClient(TestClientKnobs{Address: "http://failing", Err: errors.New("internal error")}),
+			CreateTestClient(TestClientKnobs{Address: "http://fast", MinSleep: time.Millisecond}),
+		}
+		pool := New(clients, SelectAll(), RetryEveryLimit(0, 0), Hedge{Delay: time.Second})
+
+		start := time.Now()
+		_, err := pool.BeaconBlockHeader(ctx, opts)
+		require.NoError(t, err)
+		require.Less(t, time.Since(start), 500*time.Millisecond)
+		require.Equal(t, 1, countCalls(clients[1], "BeaconBlockHeader"))
+	})
+
+	t.Run("retried client is hedged before its retries", func(t *testing.T) {
+		clients := []beacon.Client{
+			CreateTestClient(TestClientKnobs{Address: "http://failing", Err: errors.New("internal error")}),
+			CreateTestClient(TestClientKnobs{Address: "http://fast"}),
+		}
+		pool := New(clients, SelectAll(), RetryEveryLimit(time.Hour, 1), Hedge{Delay: time.Hour})
+
+		_, err := pool.BeaconBlockHeader(ctx, opts)
+		require.NoError(t, err)
+		require.Equal(t, 1, countCalls(clients[0], "BeaconBlockHeader"))
+		require.Equal(t, 1, countCalls(clients[1], "BeaconBlockHeader"))
+	})
+
+	t.Run("all clients fail", func(t *testing.T) {
+		clients := []beacon.Client{
+			CreateTestClient(TestClientKnobs{Address: "http://failing1", Err: errors.New("internal error")}),
+			CreateTestClient(TestClientKnobs{Address: "http://failing2", Err: errors.New("internal error")}),
+		}
+		pool := New(clients, SelectAll(), RetryEveryLimit(0, 0), Hedge{Delay: time.Second})
+
+		_, err := pool.BeaconBlockHeader(ctx, opts)
+		var poolErr *Error
+		require.ErrorAs(t, err, &poolErr)
+		require.Len(t, poolErr.Trace.Errors(), 2)
+	})
+}
+
+func TestHedgePercentile(t *testing.T) {
+	client := CreateTestClient(TestClientKnobs{Address: "http://mock"})
+	pool := New([]beacon.Client{client}, SelectAll(), Hedge{Delay: time.Second, Percentile: 0.9})
+
+	// Delay is used until there are enough samples.
+	require.Equal(t, time.Second, pool.hedgeDelay("BeaconBlockHeader"))
+
+	start := time.Now()
+	for i := 1; i <= 100; i++ {
+		pool.latencies.observe("BeaconBlockHeader", &CallLog{
+			Client: client,
+			Start:  start,
+			End:    start.Add(time.Duration(i) * time.Millisecond),
+		})
+	}
+	pool.latencies.observe("BeaconBlockHeader", &CallLog{Client: client, Err: errors.New("failed")})
+	require.Equal(t, 90*time.Millisecond, pool.hedgeDelay("BeaconBlockHeader"))
+	require.Equal(t, time.Second, pool.hedgeDelay("BeaconBlock"))
+
+	// The zero Hedge disables hedging.
+	require.Zero(t, pool.With(Hedge{}).hedgeDelay("BeaconBlockHeader"))
+}
@@ -0,0 +1,145 @@
+package pool
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ssvlabs/beacon-kit"
+)
+
+// LatencySelector is a Selector which keeps an exponentially weighted moving
+// average (EWMA) of the latency and success rate of each client per method,
+// and selects the fastest and most reliable clients, while occasionally
+// exploring the others to notice when they improve.
+type LatencySelector struct {
+	count   int
+	alpha   float64
+	explore float64
+
+	mu    sync.Mutex
+	stats map[latencyKey]*LatencyStats
+}
+
+type latencyKey struct {
+	address string
+	method  string
+}
+
+// LatencyStats are the moving averages of a client's calls to a method.
+type LatencyStats struct {
+	// Latency is the average duration of the calls.
+	Latency time.Duration
+
+	// SuccessRate is the average rate of successful calls, between 0 and 1.
+	SuccessRate float64
+
+	// Samples is the number of calls.
+	Samples int
+}
+
+// score returns the expected latency of a successful call,
+// penalizing clients which fail often. Lower is better.
+func (s *LatencyStats) score() float64 {
+	return float64(s.Latency) / max(s.SuccessRate, 0.01)
+}
+
+// NewLatencySelector returns a LatencySelector which selects the given number of
+// clients. alpha is the weight of the latest call in the moving averages, between
+// 0 and 1, and explore is the probability of selecting random clients instead.
+//
+// Clients without calls to a method are selected first, so that every client is measured.
+func NewLatencySelector(count int, alpha, explore float64) *LatencySelector {
+	return &LatencySelector{
+		count:   count,
+		alpha:   alpha,
+		explore: explore,
+		stats:   map[latencyKey]*LatencyStats{},
+	}
+}
+
+// Select selects the clients with the best score for the given method, or random
+// clients with the probability of exploring.
+func (s *LatencySelector) Select(method string, clients []beacon.Client) func(int, beacon.Client) bool {
+	selected := s.SelectRanked(method, clients)
+	return func(clientIndex int, client beacon.Client) bool {
+		return slices.Contains(selected, clientIndex)
+	}
+}
+
+// SelectRanked returns the indices of the clients with the best score for the given
+// method from the best to the worst, or of random clients with the probability of exploring.
+func (s *LatencySelector) SelectRanked(method string, clients []beacon.Client) []int {
+	var selected []int
+	if rand.Float64() < s.explore {
+		selected = rand.Perm(len(clients))
+	} else {
+		selected = s.rank(method, clients)
+	}
+	return selected[:min(s.count, len(selected))]
+}
+
+// rank returns the indices of the given clients from the best
+// to the worst score for the given method.
+func (s *LatencySelector) rank(method string, clients []beacon.Client) []int {
+	s.mu.Lock()
+	scores := make([]float64, len(clients))
+	for i, client := range clients {
+		if stats, ok := s.stats[latencyKey{client.Address(), method}]; ok {
+			scores[i] = stats.score()
+		}
+	}
+	s.mu.Unlock()
+
+	ranked := make([]int, len(clients))
+	for i := range ranked {
+		ranked[i] = i
+	}
+	slices.SortStableFunc(ranked, func(a, b int) int {
+		return cmp.Compare(scores[a], scores[b])
+	})
+	return ranked
+}
+
+// Observe updates the moving averages of the client of the given CallLog.
+// Calls which were cancelled, such as after another client succeeded, are ignored.
+func (s *LatencySelector) Observe(method string, log CallLog) {
+	if errors.Is(log.Err, context.Canceled) {
+		return
+	}
+	latency := log.End.Sub(log.Start)
+	success := 0.0
+	if log.Err == nil || errors.Is(log.Err, beacon.ErrBlockNotFound) {
+		success = 1
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := latencyKey{log.Client.Address(), method}
+	stats, ok := s.stats[key]
+	if !ok {
+		s.stats[key] = &LatencyStats{Latency: latency, SuccessRate: success, Samples: 1}
+		return
+	}
+	stats.Latency = time.Duration(s.alpha*float64(latency) + (1-s.alpha)*float64(stats.Latency))
+	stats.SuccessRate = s.alpha*success + (1-s.alpha)*stats.SuccessRate
+	stats.Samples++
+}
+
+// Stats returns the moving averages of the given client's calls to the
+// given method, or false if it wasn't called yet.
+func (s *LatencySelector) Stats(method string, client beacon.Client) (LatencyStats, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats, ok := s.stats[latencyKey{client.Address(), method}]
+	if !ok {
+		return LatencyStats{}, false
+	}
+	return *stats, true
+}
@@ -0,0 +1,72 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/ssvlabs/beacon-kit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencySelector(t *testing.T) {
+	ctx := context.Background()
+	opts := &api.BeaconBlockHeaderOpts{Block: "32"}
+	clients := []beacon.Client{
+		CreateTestClient(TestClientKnobs{Address: "http://slow"}),
+		CreateTestClient(TestClientKnobs{Address: "http://failing", Err: errors.New("internal error")}),
+		CreateTestClient(TestClientKnobs{Address: "http://fast"}),
+		CreateTestClient(TestClientKnobs{Address: "http://new"}),
+	}
+	selector := NewLatencySelector(2, 0.5, 0)
+	observe := func(client beacon.Client, latency time.Duration, err error) {
+		start := time.Now()
+		selector.Observe("BeaconBlockHeader", CallLog{Client: client, Start: start, End: start.Add(latency), Err: err})
+	}
+	for range 4 {
+		observe(clients[0], 20*time.Millisecond, nil)
+		observe(clients[1], time.Millisecond, errors.New("internal error"))
+		observe(clients[2], time.Millisecond, nil)
+	}
+	observe(clients[2], time.Second, context.Canceled)
+
+	stats, ok := selector.Stats("BeaconBlockHeader", clients[2])
+	require.True(t, ok)
+	require.Equal(t, LatencyStats{Latency: time.Millisecond, SuccessRate: 1, Samples: 4}, stats)
+	stats, ok = selector.Stats("BeaconBlockHeader", clients[1])
+	require.True(t, ok)
+	require.Zero(t, stats.SuccessRate)
+	_, ok = selector.Stats("AttestationData", clients[2])
+	require.False(t, ok)
+
+	// Clients without calls are ranked first so that they're measured,
+	// then the fast and reliable clients.
+	require.Equal(t, []int{3, 2}, selector.SelectRanked("BeaconBlockHeader", clients))
+	selectFunc := selector.Select("BeaconBlockHeader", clients)
+	for i, client := range clients {
+		require.Equal(t, i >= 2, selectFunc(i, client), "client %d", i)
+	}
+
+	// Calls try the ranked clients in order, so hedging calls the best client first.
+	pool := New(clients, selector, RetryEveryLimit(0, 0), Hedge{Delay: time.Minute})
+	_, err := pool.BeaconBlockHeader(ctx, opts)
+	require.NoError(t, err)
+	for i, calls := range []int{0, 0, 0, 1} {
+		require.Equal(t, calls, countCalls(clients[i], "BeaconBlockHeader"), "client %d", i)
+	}
+
+	// Exploring selects the other clients too.
+	pool = New(clients, NewLatencySelector(1, 0.5, 1), RetryEveryLimit(0, 0))
+	for range 50 {
+		_, _ = pool.BeaconBlockHeader(ctx, opts)
+	}
+	for i, client := range clients {
+		require.Greater(t, countCalls(client, "BeaconBlockHeader"), 5, "client %d", i)
+	}
+
+	// A SelectFunc replaces the Selector.
+	require.Nil(t, pool.With(SelectAll()).Scope().Selector)
+	require.NotNil(t, pool.Scope().Selector)
+}
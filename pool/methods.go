@@ -33,12 +33,20 @@ func methodFromContext(ctx context.Context) string {
 	return method
 }
 
+type argsCtxKey struct{}
+
+func argsFromContext(ctx context.Context) []any {
+	args, _ := ctx.Value(argsCtxKey{}).([]any)
+	return args
+}
+
 func (m *methods) Address() (s1 string) {
 	return m.defaultClient().Address()
 }
 
 func (m *methods) AggregateAttestation(ctx context.Context, opts *api.AggregateAttestationOpts) (pp1 *api.Response[*spec.VersionedAttestation], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "AggregateAttestation")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[*spec.VersionedAttestation]
 		err error
@@ -53,14 +61,15 @@ func (m *methods) AggregateAttestation(ctx context.Context, opts *api.AggregateA
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) AttestationData(ctx context.Context, opts *api.AttestationDataOpts) (pp1 *api.Response[*phase0.AttestationData], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "AttestationData")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[*phase0.AttestationData]
 		err error
@@ -75,14 +84,15 @@ func (m *methods) AttestationData(ctx context.Context, opts *api.AttestationData
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) AttesterDuties(ctx context.Context, opts *api.AttesterDutiesOpts) (pp1 *api.Response[[]*apiv1.AttesterDuty], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "AttesterDuties")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[[]*apiv1.AttesterDuty]
 		err error
@@ -97,14 +107,15 @@ func (m *methods) AttesterDuties(ctx context.Context, opts *api.AttesterDutiesOp
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) BeaconBlockHeader(ctx context.Context, opts *api.BeaconBlockHeaderOpts) (pp1 *api.Response[*apiv1.BeaconBlockHeader], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "BeaconBlockHeader")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[*apiv1.BeaconBlockHeader]
 		err error
@@ -119,14 +130,15 @@ func (m *methods) BeaconBlockHeader(ctx context.Context, opts *api.BeaconBlockHe
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) BeaconBlockRoot(ctx context.Context, opts *api.BeaconBlockRootOpts) (pp1 *api.Response[*phase0.Root], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "BeaconBlockRoot")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[*phase0.Root]
 		err error
@@ -141,14 +153,15 @@ func (m *methods) BeaconBlockRoot(ctx context.Context, opts *api.BeaconBlockRoot
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) BeaconCommittees(ctx context.Context, opts *api.BeaconCommitteesOpts) (pp1 *api.Response[[]*apiv1.BeaconCommittee], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "BeaconCommittees")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[[]*apiv1.BeaconCommittee]
 		err error
@@ -163,14 +176,15 @@ func (m *methods) BeaconCommittees(ctx context.Context, opts *api.BeaconCommitte
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) Domain(ctx context.Context, domainType phase0.DomainType, epoch phase0.Epoch) (d1 phase0.Domain, err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "Domain")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{domainType, epoch})
 	type _resultStruct struct {
 		d1  phase0.Domain
 		err error
@@ -185,14 +199,15 @@ func (m *methods) Domain(ctx context.Context, domainType phase0.DomainType, epoc
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, d1, func() { _result = _response })
 	})
 	return _result.d1, _result.err
 }
 
 func (m *methods) Events(ctx context.Context, opts *api.EventsOpts) (err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "Events")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		err error
 	}
@@ -206,14 +221,15 @@ func (m *methods) Events(ctx context.Context, opts *api.EventsOpts) (err error)
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, nil, func() { _result = _response })
 	})
 	return _result.err
 }
 
 func (m *methods) Genesis(ctx context.Context, opts *api.GenesisOpts) (pp1 *api.Response[*apiv1.Genesis], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "Genesis")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[*apiv1.Genesis]
 		err error
@@ -228,14 +244,15 @@ func (m *methods) Genesis(ctx context.Context, opts *api.GenesisOpts) (pp1 *api.
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) GenesisDomain(ctx context.Context, domainType phase0.DomainType) (d1 phase0.Domain, err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "GenesisDomain")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{domainType})
 	type _resultStruct struct {
 		d1  phase0.Domain
 		err error
@@ -250,8 +267,8 @@ func (m *methods) GenesisDomain(ctx context.Context, domainType phase0.DomainTyp
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, d1, func() { _result = _response })
 	})
 	return _result.d1, _result.err
 }
@@ -270,6 +287,7 @@ func (m *methods) Name() (s1 string) {
 
 func (m *methods) Proposal(ctx context.Context, opts *api.ProposalOpts) (pp1 *api.Response[*api.VersionedProposal], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "Proposal")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[*api.VersionedProposal]
 		err error
@@ -284,14 +302,15 @@ func (m *methods) Proposal(ctx context.Context, opts *api.ProposalOpts) (pp1 *ap
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) ProposerDuties(ctx context.Context, opts *api.ProposerDutiesOpts) (pp1 *api.Response[[]*apiv1.ProposerDuty], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "ProposerDuties")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[[]*apiv1.ProposerDuty]
 		err error
@@ -306,14 +325,15 @@ func (m *methods) ProposerDuties(ctx context.Context, opts *api.ProposerDutiesOp
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) SignedBeaconBlock(ctx context.Context, opts *api.SignedBeaconBlockOpts) (pp1 *api.Response[*spec.VersionedSignedBeaconBlock], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SignedBeaconBlock")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[*spec.VersionedSignedBeaconBlock]
 		err error
@@ -328,14 +348,15 @@ func (m *methods) SignedBeaconBlock(ctx context.Context, opts *api.SignedBeaconB
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) Spec(ctx context.Context, opts *api.SpecOpts) (pp1 *api.Response[map[string]any], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "Spec")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[map[string]any]
 		err error
@@ -350,14 +371,15 @@ func (m *methods) Spec(ctx context.Context, opts *api.SpecOpts) (pp1 *api.Respon
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) SubmitAggregateAttestations(ctx context.Context, opts *api.SubmitAggregateAttestationsOpts) (err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SubmitAggregateAttestations")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		err error
 	}
@@ -371,14 +393,15 @@ func (m *methods) SubmitAggregateAttestations(ctx context.Context, opts *api.Sub
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, nil, func() { _result = _response })
 	})
 	return _result.err
 }
 
 func (m *methods) SubmitAttestations(ctx context.Context, opts *api.SubmitAttestationsOpts) (err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SubmitAttestations")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		err error
 	}
@@ -392,14 +415,15 @@ func (m *methods) SubmitAttestations(ctx context.Context, opts *api.SubmitAttest
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, nil, func() { _result = _response })
 	})
 	return _result.err
 }
 
 func (m *methods) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.BeaconCommitteeSubscription) (err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SubmitBeaconCommitteeSubscriptions")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{subscriptions})
 	type _resultStruct struct {
 		err error
 	}
@@ -413,14 +437,15 @@ func (m *methods) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscr
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, nil, func() { _result = _response })
 	})
 	return _result.err
 }
 
 func (m *methods) SubmitBlindedProposal(ctx context.Context, opts *api.SubmitBlindedProposalOpts) (err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SubmitBlindedProposal")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		err error
 	}
@@ -434,14 +459,15 @@ func (m *methods) SubmitBlindedProposal(ctx context.Context, opts *api.SubmitBli
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, nil, func() { _result = _response })
 	})
 	return _result.err
 }
 
 func (m *methods) SubmitProposal(ctx context.Context, opts *api.SubmitProposalOpts) (err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SubmitProposal")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		err error
 	}
@@ -455,14 +481,15 @@ func (m *methods) SubmitProposal(ctx context.Context, opts *api.SubmitProposalOp
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, nil, func() { _result = _response })
 	})
 	return _result.err
 }
 
 func (m *methods) SubmitProposalPreparations(ctx context.Context, preparations []*apiv1.ProposalPreparation) (err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SubmitProposalPreparations")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{preparations})
 	type _resultStruct struct {
 		err error
 	}
@@ -476,14 +503,15 @@ func (m *methods) SubmitProposalPreparations(ctx context.Context, preparations [
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, nil, func() { _result = _response })
 	})
 	return _result.err
 }
 
 func (m *methods) SubmitSyncCommitteeContributions(ctx context.Context, contributionAndProofs []*altair.SignedContributionAndProof) (err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SubmitSyncCommitteeContributions")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{contributionAndProofs})
 	type _resultStruct struct {
 		err error
 	}
@@ -497,14 +525,15 @@ func (m *methods) SubmitSyncCommitteeContributions(ctx context.Context, contribu
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, nil, func() { _result = _response })
 	})
 	return _result.err
 }
 
 func (m *methods) SubmitSyncCommitteeMessages(ctx context.Context, messages []*altair.SyncCommitteeMessage) (err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SubmitSyncCommitteeMessages")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{messages})
 	type _resultStruct struct {
 		err error
 	}
@@ -518,14 +547,15 @@ func (m *methods) SubmitSyncCommitteeMessages(ctx context.Context, messages []*a
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, nil, func() { _result = _response })
 	})
 	return _result.err
 }
 
 func (m *methods) SubmitSyncCommitteeSubscriptions(ctx context.Context, subscriptions []*apiv1.SyncCommitteeSubscription) (err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SubmitSyncCommitteeSubscriptions")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{subscriptions})
 	type _resultStruct struct {
 		err error
 	}
@@ -539,14 +569,15 @@ func (m *methods) SubmitSyncCommitteeSubscriptions(ctx context.Context, subscrip
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, nil, func() { _result = _response })
 	})
 	return _result.err
 }
 
 func (m *methods) SubmitValidatorRegistrations(ctx context.Context, registrations []*api.VersionedSignedValidatorRegistration) (err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SubmitValidatorRegistrations")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{registrations})
 	type _resultStruct struct {
 		err error
 	}
@@ -560,14 +591,15 @@ func (m *methods) SubmitValidatorRegistrations(ctx context.Context, registration
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, nil, func() { _result = _response })
 	})
 	return _result.err
 }
 
 func (m *methods) SyncCommitteeContribution(ctx context.Context, opts *api.SyncCommitteeContributionOpts) (pp1 *api.Response[*altair.SyncCommitteeContribution], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SyncCommitteeContribution")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[*altair.SyncCommitteeContribution]
 		err error
@@ -582,14 +614,15 @@ func (m *methods) SyncCommitteeContribution(ctx context.Context, opts *api.SyncC
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) SyncCommitteeDuties(ctx context.Context, opts *api.SyncCommitteeDutiesOpts) (pp1 *api.Response[[]*apiv1.SyncCommitteeDuty], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "SyncCommitteeDuties")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[[]*apiv1.SyncCommitteeDuty]
 		err error
@@ -604,14 +637,15 @@ func (m *methods) SyncCommitteeDuties(ctx context.Context, opts *api.SyncCommitt
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) ValidatorBalances(ctx context.Context, opts *api.ValidatorBalancesOpts) (pp1 *api.Response[map[phase0.ValidatorIndex]phase0.Gwei], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "ValidatorBalances")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[map[phase0.ValidatorIndex]phase0.Gwei]
 		err error
@@ -626,14 +660,15 @@ func (m *methods) ValidatorBalances(ctx context.Context, opts *api.ValidatorBala
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
 
 func (m *methods) Validators(ctx context.Context, opts *api.ValidatorsOpts) (pp1 *api.Response[map[phase0.ValidatorIndex]*apiv1.Validator], err error) {
 	ctx = context.WithValue(ctx, methodCtxKey{}, "Validators")
+	ctx = context.WithValue(ctx, argsCtxKey{}, []any{opts})
 	type _resultStruct struct {
 		pp1 *api.Response[map[phase0.ValidatorIndex]*apiv1.Validator]
 		err error
@@ -648,8 +683,8 @@ func (m *methods) Validators(ctx context.Context, opts *api.ValidatorsOpts) (pp1
 		if err != nil {
 			return err
 		}
-		_result = _unchecked
-		return nil
+		_response := _unchecked
+		return respond(ctx, pp1, func() { _result = _response })
 	})
 	return _result.pp1, _result.err
 }
@@ -0,0 +1,128 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	ssz "github.com/ferranbt/fastssz"
+)
+
+// Quorum is the number of selected clients which must return equal responses for
+// a call to succeed, such as 2 with SelectAll in a pool of 3 clients, to defend
+// against a single buggy or malicious client. Calls with a Quorum call the selected
+// clients until enough of them agree, and otherwise fail with a DisagreementError,
+// or with ErrQuorumUnreachable if fewer clients are selected.
+// It takes precedence over FirstSuccess and Hedge. The zero Quorum disables it.
+//
+// Responses are compared by the SSZ hash tree root of their Data if it has one,
+// or by deep equality otherwise. Calls made with Client.Call directly have no
+// responses to compare, so they only require the given number of successes.
+type Quorum int
+
+// ErrQuorumUnreachable is returned by calls with a Quorum
+// greater than the number of selected clients.
+var ErrQuorumUnreachable = errors.New("quorum is unreachable")
+
+// DisagreementError is returned by calls with a Quorum when some clients
+// succeeded but not enough of them returned equal responses. Calls fail as
+// soon as the clients which didn't answer yet can't reach the Quorum.
+type DisagreementError struct {
+	Quorum Quorum
+
+	// Answers is the last attempt of each client which answered,
+	// with either its Response or Err.
+	Answers CallTrace
+}
+
+func (e *DisagreementError) Error() string {
+	var agreements [][]*CallLog
+	failed := 0
+	for i := range e.Answers {
+		if e.Answers[i].Err != nil {
+			failed++
+			continue
+		}
+		agreements = agree(agreements, &e.Answers[i])
+	}
+	largest := 0
+	for _, agreement := range agreements {
+		largest = max(largest, len(agreement))
+	}
+	return fmt.Sprintf("no quorum of %d clients: %d distinct responses (largest agreed by %d), %d errors\n%s",
+		e.Quorum, len(agreements), largest, failed, e.Answers)
+}
+
+// quorumReachable returns true if the largest of the given agreements, joined
+// by the given number of remaining clients, would reach the given Quorum.
+func quorumReachable(quorum Quorum, agreements [][]*CallLog, remaining int) bool {
+	largest := 0
+	for _, agreement := range agreements {
+		largest = max(largest, len(agreement))
+	}
+	return largest+remaining >= int(quorum)
+}
+
+// agree adds the given successful CallLog to the agreement
+// of the clients which returned an equal response.
+func agree(agreements [][]*CallLog, log *CallLog) [][]*CallLog {
+	for i, agreement := range agreements {
+		if equalResponses(agreement[0].Response, log.Response) {
+			agreements[i] = append(agreement, log)
+			return agreements
+		}
+	}
+	return append(agreements, []*CallLog{log})
+}
+
+// equalResponses returns true if the given responses have equal
+// SSZ hash tree roots, or are deeply equal otherwise.
+func equalResponses(a, b any) bool {
+	a, b = responseData(a), responseData(b)
+	if a, ok := a.(ssz.HashRoot); ok {
+		if b, ok := b.(ssz.HashRoot); ok {
+			rootA, errA := a.HashTreeRoot()
+			rootB, errB := b.HashTreeRoot()
+			if errA == nil && errB == nil {
+				return rootA == rootB
+			}
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// responseData returns the Data of the given *api.Response,
+// or the given response if it isn't one.
+func responseData(response any) any {
+	v := reflect.ValueOf(response)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return response
+	}
+	data := v.Elem().FieldByName("Data")
+	if !data.IsValid() || !data.CanInterface() {
+		return response
+	}
+	return data.Interface()
+}
+
+type responseCtxKey struct{}
+
+// callResponse is the response of an attempt, which is
+// recorded instead of committed when comparing responses.
+type callResponse struct {
+	response any
+	commit   func()
+}
+
+// respond is called by the methods with the response of each successful attempt.
+// It commits the response as the result of the call, unless the call compares
+// responses, in which case the call commits it once a Quorum agrees.
+func respond(ctx context.Context, response any, commit func()) error {
+	if r, ok := ctx.Value(responseCtxKey{}).(*callResponse); ok {
+		r.response, r.commit = response, commit
+		return nil
+	}
+	commit()
+	return nil
+}
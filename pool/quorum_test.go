@@ -0,0 +1,146 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/beacon-kit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuorum(t *testing.T) {
+	ctx := context.Background()
+	opts := &api.AttestationDataOpts{Slot: 1}
+	failed := errors.New("internal error")
+
+	t.Run("majority agrees", func(t *testing.T) {
+		pool := New([]beacon.Client{
+			CreateTestClient(TestClientKnobs{Address: "http://a", Slot: 1}),
+			CreateTestClient(TestClientKnobs{Address: "http://b", Slot: 2}),
+			CreateTestClient(TestClientKnobs{Address: "http://c", Slot: 1}),
+		}, SelectAll(), RetryEveryLimit(0, 0), Quorum(2))
+
+		resp, err := pool.AttestationData(ctx, opts)
+		require.NoError(t, err)
+		require.Equal(t, phase0.Slot(1), resp.Data.Slot)
+	})
+
+	t.Run("hedge is ignored", func(t *testing.T) {
+		pool := New([]beacon.Client{
+			CreateTestClient(TestClientKnobs{Address: "http://a", Slot: 1}),
+			CreateTestClient(TestClientKnobs{Address: "http://b", Slot: 1}),
+			CreateTestClient(TestClientKnobs{Address: "http://c", Slot: 2}),
+		}, SelectAll(), RetryEveryLimit(0, 0), Quorum(2), Hedge{Delay: time.Hour})
+
+		// Every client is called at once instead of every Hedge.Delay.
+		resp, err := pool.AttestationData(ctx, opts)
+		require.NoError(t, err)
+		require.Equal(t, phase0.Slot(1), resp.Data.Slot)
+	})
+
+	t.Run("failed client", func(t *testing.T) {
+		pool := New([]beacon.Client{
+			CreateTestClient(TestClientKnobs{Address: "http://a", Slot: 1, Err: failed}),
+			CreateTestClient(TestClientKnobs{Address: "http://b", Slot: 1}),
+			CreateTestClient(TestClientKnobs{Address: "http://c", Slot: 1}),
+		}, SelectAll(), RetryEveryLimit(0, 0), Quorum(2))
+
+		resp, err := pool.AttestationData(ctx, opts)
+		require.NoError(t, err)
+		require.Equal(t, phase0.Slot(1), resp.Data.Slot)
+	})
+
+	t.Run("disagreement", func(t *testing.T) {
+		pool := New([]beacon.Client{
+			CreateTestClient(TestClientKnobs{Address: "http://a", Slot: 1}),
+			CreateTestClient(TestClientKnobs{Address: "http://b", Slot: 2}),
+			CreateTestClient(TestClientKnobs{Address: "http://c", Slot: 3, Err: failed}),
+		}, SelectAll(), RetryEveryLimit(0, 0), Quorum(2))
+
+		resp, err := pool.AttestationData(ctx, opts)
+		require.Nil(t, resp)
+		var disagreement *DisagreementError
+		require.ErrorAs(t, err, &disagreement)
+		require.Equal(t, Quorum(2), disagreement.Quorum)
+		require.Len(t, disagreement.Answers, 3)
+		slots := map[string]phase0.Slot{}
+		for _, answer := range disagreement.Answers {
+			if answer.Err != nil {
+				require.Equal(t, "http://c", answer.Client.Address())
+				continue
+			}
+			slots[answer.Client.Address()] = answer.Response.(*api.Response[*phase0.AttestationData]).Data.Slot
+		}
+		require.Equal(t, map[string]phase0.Slot{"http://a": 1, "http://b": 2}, slots)
+		require.Contains(t, err.Error(), "no quorum of 2 clients: 2 distinct responses (largest agreed by 1), 1 errors")
+	})
+
+	t.Run("unreachable quorum", func(t *testing.T) {
+		clients := []beacon.Client{
+			CreateTestClient(TestClientKnobs{Address: "http://a", Slot: 1}),
+			CreateTestClient(TestClientKnobs{Address: "http://b", Slot: 1}),
+		}
+		pool := New(clients, SelectAll(), RetryEveryLimit(0, 0), Quorum(3))
+
+		_, err := pool.AttestationData(ctx, opts)
+		require.ErrorIs(t, err, ErrQuorumUnreachable)
+		for _, client := range clients {
+			require.Zero(t, countCalls(client, "AttestationData"))
+		}
+	})
+
+	t.Run("disagreement fails early", func(t *testing.T) {
+		pool := New([]beacon.Client{
+			CreateTestClient(TestClientKnobs{Address: "http://a", Slot: 1}),
+			CreateTestClient(TestClientKnobs{Address: "http://b", Slot: 2}),
+			CreateTestClient(TestClientKnobs{Address: "http://c", Slot: 3, MinSleep: 200 * time.Millisecond}),
+		}, SelectAll(), RetryEveryLimit(0, 0), Quorum(3))
+
+		// Once a and b disagree, c can't complete the quorum, so it isn't awaited.
+		_, err := pool.AttestationData(ctx, opts)
+		var disagreement *DisagreementError
+		require.ErrorAs(t, err, &disagreement)
+		require.Len(t, disagreement.Answers, 2)
+		for _, answer := range disagreement.Answers {
+			require.NotEqual(t, "http://c", answer.Client.Address())
+		}
+	})
+
+	t.Run("all clients fail", func(t *testing.T) {
+		pool := New([]beacon.Client{
+			CreateTestClient(TestClientKnobs{Address: "http://a", Slot: 1, Err: failed}),
+			CreateTestClient(TestClientKnobs{Address: "http://b", Slot: 1, Err: failed}),
+		}, SelectAll(), RetryEveryLimit(0, 0), Quorum(2))
+
+		_, err := pool.AttestationData(ctx, opts)
+		var poolErr *Error
+		require.ErrorAs(t, err, &poolErr)
+	})
+}
+
+func TestEqualResponses(t *testing.T) {
+	// SSZ responses are compared by hash tree root, ignoring the Metadata.
+	require.True(t, equalResponses(
+		&api.Response[*phase0.AttestationData]{Data: attestationData(1), Metadata: map[string]any{"a": 1}},
+		&api.Response[*phase0.AttestationData]{Data: attestationData(1)},
+	))
+	require.False(t, equalResponses(
+		&api.Response[*phase0.AttestationData]{Data: attestationData(1)},
+		&api.Response[*phase0.AttestationData]{Data: attestationData(2)},
+	))
+
+	// Other responses are compared by deep equality.
+	require.True(t, equalResponses(
+		&api.Response[map[phase0.ValidatorIndex]phase0.Gwei]{Data: map[phase0.ValidatorIndex]phase0.Gwei{1: 32}},
+		&api.Response[map[phase0.ValidatorIndex]phase0.Gwei]{Data: map[phase0.ValidatorIndex]phase0.Gwei{1: 32}},
+	))
+	require.False(t, equalResponses(
+		&api.Response[map[phase0.ValidatorIndex]phase0.Gwei]{Data: map[phase0.ValidatorIndex]phase0.Gwei{1: 32}},
+		&api.Response[map[phase0.ValidatorIndex]phase0.Gwei]{Data: map[phase0.ValidatorIndex]phase0.Gwei{1: 31}},
+	))
+	require.True(t, equalResponses(nil, nil))
+}
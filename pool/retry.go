@@ -0,0 +1,51 @@
+package pool
+
+import (
+	"sync"
+	"time"
+)
+
+// RetryBudget limits the retries of all calls to the pool with a token bucket,
+// to prevent retry storms when clients fail: each retry takes a token, and
+// retries are skipped while there is none. The zero RetryBudget is unlimited.
+type RetryBudget struct {
+	// Rate is the number of tokens added per second.
+	Rate float64
+
+	// Burst is the maximum number of tokens, which the bucket starts with.
+	// A RetryBudget without a positive Burst is unlimited regardless of its Rate,
+	// since the bucket couldn't hold any token.
+	Burst int
+}
+
+// retryBudget is the token bucket of the RetryBudget,
+// which is shared between copies of Client.
+type retryBudget struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	tokens  float64
+	updated time.Time
+}
+
+// take takes a token for a retry, or returns false if there is none.
+// It always returns true if the given RetryBudget has no positive Burst.
+func (b *retryBudget) take(config RetryBudget) bool {
+	if config.Burst <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if b.updated.IsZero() {
+		b.tokens = float64(config.Burst)
+	} else {
+		b.tokens = min(float64(config.Burst), b.tokens+now.Sub(b.updated).Seconds()*config.Rate)
+	}
+	b.updated = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
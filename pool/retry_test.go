@@ -0,0 +1,82 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/ssvlabs/beacon-kit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryBudget(t *testing.T) {
+	now := time.Now()
+	budget := &retryBudget{now: func() time.Time { return now }}
+	config := RetryBudget{Rate: 2, Burst: 3}
+
+	// The bucket starts full.
+	for range 3 {
+		require.True(t, budget.take(config))
+	}
+	require.False(t, budget.take(config))
+
+	// Tokens are added at the given rate, up to the burst.
+	now = now.Add(time.Second)
+	require.True(t, budget.take(config))
+	require.True(t, budget.take(config))
+	require.False(t, budget.take(config))
+	now = now.Add(time.Hour)
+	for range 3 {
+		require.True(t, budget.take(config))
+	}
+	require.False(t, budget.take(config))
+
+	// The zero RetryBudget, or any without a Burst, is unlimited.
+	require.True(t, budget.take(RetryBudget{}))
+	require.True(t, budget.take(RetryBudget{Rate: 1}))
+
+	// Calls skip retries once the budget is exhausted.
+	ctx := context.Background()
+	opts := &api.BeaconBlockHeaderOpts{Block: "1"}
+	client := CreateTestClient(TestClientKnobs{Address: "http://failing", Err: errors.New("internal error")})
+	pool := New([]beacon.Client{client}, RetryEveryLimit(0, 10), RetryBudget{Burst: 3})
+
+	_, err := pool.BeaconBlockHeader(ctx, opts)
+	require.Error(t, err)
+	require.Equal(t, 4, countCalls(client, "BeaconBlockHeader"))
+
+	_, err = pool.BeaconBlockHeader(ctx, opts)
+	require.Error(t, err)
+	require.Equal(t, 5, countCalls(client, "BeaconBlockHeader"))
+}
+
+func TestRetryDoesntBlock(t *testing.T) {
+	// A client waiting to retry doesn't delay the success of another,
+	// which only responds once the first client has failed.
+	var failedOnce sync.Once
+	failed := make(chan struct{})
+	failing := CreateTestClient(TestClientKnobs{
+		Address: "http://failing",
+		Hook: func(ctx context.Context) error {
+			failedOnce.Do(func() { close(failed) })
+			return errors.New("internal error")
+		},
+	})
+	slow := CreateTestClient(TestClientKnobs{
+		Address: "http://slow",
+		Hook: func(ctx context.Context) error {
+			<-failed
+			return nil
+		},
+	})
+	pool := New([]beacon.Client{failing, slow}, SelectAll(), RetryEveryLimit(time.Hour, 1))
+
+	resp, err := pool.BeaconBlockHeader(context.Background(), &api.BeaconBlockHeaderOpts{Block: "1"})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.Equal(t, 1, countCalls(failing, "BeaconBlockHeader"))
+	require.Equal(t, 1, countCalls(slow, "BeaconBlockHeader"))
+}
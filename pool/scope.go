@@ -11,11 +11,19 @@ import (
 // Scope dictates the behaviour of calls to the pool.
 type Scope struct {
 	Select       SelectFunc
+	Selector     Selector
 	Retry        RetryFunc
 	Timeout      Timeout
+	Deadline     Deadline
 	Concurrency  Concurrency
 	FirstSuccess FirstSuccess
 	Trace        Trace
+
+	CircuitBreaker CircuitBreaker
+	Hedge          Hedge
+	Quorum         Quorum
+	Divergence     Divergence
+	RetryBudget    RetryBudget
 }
 
 func (s *Scope) apply(options ...interface{}) {
@@ -23,16 +31,31 @@ func (s *Scope) apply(options ...interface{}) {
 		switch v := option.(type) {
 		case SelectFunc:
 			s.Select = v
+			s.Selector = nil
+		case Selector:
+			s.Selector = v
 		case RetryFunc:
 			s.Retry = v
 		case Timeout:
 			s.Timeout = v
+		case Deadline:
+			s.Deadline = v
 		case Concurrency:
 			s.Concurrency = v
 		case FirstSuccess:
 			s.FirstSuccess = v
 		case Trace:
 			s.Trace = v
+		case CircuitBreaker:
+			s.CircuitBreaker = v
+		case Hedge:
+			s.Hedge = v
+		case Quorum:
+			s.Quorum = v
+		case Divergence:
+			s.Divergence = v
+		case RetryBudget:
+			s.RetryBudget = v
 		}
 	}
 }
@@ -112,6 +135,29 @@ func SelectAdjacentRandoms(count int) SelectFunc {
 	}
 }
 
+// Selector is a stateful alternative to SelectFunc, which selects clients
+// knowing the called method and learns from the outcome of calls.
+// It takes precedence over SelectFunc, until a SelectFunc is applied.
+type Selector interface {
+	// Select returns a function that decides whether to call a client,
+	// for a call to the given method, such as "AttestationData".
+	Select(method string, clients []beacon.Client) func(int, beacon.Client) bool
+
+	// Observe receives the CallLog of every attempt of a call to the given method.
+	Observe(method string, log CallLog)
+}
+
+// RankedSelector is a Selector which also ranks the clients it selects.
+// Calls try the selected clients from the best to the worst instead of in
+// the order of the pool, so that hedged calls (see Hedge) call the best first.
+type RankedSelector interface {
+	Selector
+
+	// SelectRanked returns the indices of the clients to call for a call
+	// to the given method, from the best to the worst.
+	SelectRanked(method string, clients []beacon.Client) []int
+}
+
 // RetryFunc determines whether to retry an individual client call or not.
 type RetryFunc func(tries int, err error) (time.Duration, bool)
 
@@ -133,9 +179,35 @@ func RetryEveryLimit(every time.Duration, limit int) RetryFunc {
 	}
 }
 
+// RetryBackoff returns a RetryFunc which retries up to a given limit with an
+// exponential backoff and full jitter: the delay before each retry is random
+// between zero and base doubled for every previous try, up to maxDelay.
+func RetryBackoff(base, maxDelay time.Duration, limit int) RetryFunc {
+	return func(tries int, err error) (time.Duration, bool) {
+		if tries >= limit {
+			return 0, false
+		}
+		backoff := base
+		for i := 0; i < tries && backoff < maxDelay; i++ {
+			backoff *= 2
+		}
+		backoff = min(backoff, maxDelay)
+		if backoff <= 0 {
+			return 0, true
+		}
+		return time.Duration(rand.Int63n(int64(backoff) + 1)), true
+	}
+}
+
 // Timeout is the timeout for each individual call a client.
 type Timeout time.Duration
 
+// Deadline is the time by which each call to the pool must complete, including
+// retries, such as clock.Moment.AttestationPropagationDeadline. Retries which
+// would start after the Deadline are skipped, and calls which are still running
+// at the Deadline fail with context.DeadlineExceeded. The zero Deadline means no deadline.
+type Deadline time.Time
+
 // Concurrency is the limit of concurrent calls each call
 // to the pool can make.
 type Concurrency int
@@ -2,6 +2,7 @@ package pool
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -30,3 +31,17 @@ func TestSelectRandoms(t *testing.T) {
 		}
 	}
 }
+
+func TestRetryBackoff(t *testing.T) {
+	retry := RetryBackoff(10*time.Millisecond, 50*time.Millisecond, 5)
+	for tries, maxDelay := range []time.Duration{10, 20, 40, 50, 50} {
+		for range 100 {
+			delay, ok := retry(tries, nil)
+			require.True(t, ok)
+			require.GreaterOrEqual(t, delay, time.Duration(0))
+			require.LessOrEqual(t, delay, maxDelay*time.Millisecond, "tries %d", tries)
+		}
+	}
+	_, ok := retry(5, nil)
+	require.False(t, ok)
+}
@@ -0,0 +1,172 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	v1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/beacon-kit"
+	"github.com/ssvlabs/beacon-kit/clock"
+)
+
+// ClientSkew is the estimated skew of the local clock relative to a client.
+type ClientSkew struct {
+	Client beacon.Client
+
+	// Skew is the estimated offset of the local clock relative to the client,
+	// which is positive if the local clock is ahead. It's the minimum delay between
+	// the start of a slot and the arrival of its head event, so it includes the
+	// propagation delay of blocks and is an upper bound of the actual skew.
+	Skew time.Duration
+
+	// Samples is the number of head events which Skew is estimated from.
+	// Skew is meaningless if it's zero.
+	Samples int
+
+	// HeadSlot is the slot of the client's head block header.
+	HeadSlot phase0.Slot
+
+	// HeadDistance is the current slot of the local clock minus HeadSlot.
+	// It's negative if the local clock is behind by whole slots, and positive
+	// if the client is syncing, the last slots were empty or the local
+	// clock is ahead by whole slots.
+	HeadDistance int64
+
+	// Err is set if the client's head block header could not be fetched.
+	Err error
+}
+
+func (s *ClientSkew) String() string {
+	if s.Err != nil {
+		return fmt.Sprintf("⨉ %s -> %s", s.Client.Address(), s.Err)
+	}
+	return fmt.Sprintf("%s: skew %s (%d samples), head %d (distance %d)",
+		s.Client.Address(), s.Skew, s.Samples, s.HeadSlot, s.HeadDistance)
+}
+
+// SkewReport is the estimated skew of the local clock relative to the clients of a pool.
+type SkewReport struct {
+	Clients []ClientSkew
+
+	// Skew is the median Skew of the clients with samples.
+	Skew time.Duration
+}
+
+func (r SkewReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "SkewReport: skew %s", r.Skew)
+	for i := range r.Clients {
+		b.WriteString("\n\t" + r.Clients[i].String())
+	}
+	return b.String()
+}
+
+// MonitorSkew estimates the skew of the local clock relative to the clients in the pool,
+// by comparing the given Clock with the arrival times of head events over the last
+// window head events, and with the slots of the head block headers of the clients.
+//
+// A SkewReport is passed to the given function at 2/3 of every slot, after the
+// head events of the slot are expected to have arrived, until the context is cancelled.
+// MonitorSkew only monitors the clients which are in the pool when it's called,
+// and returns once their head events are subscribed to (see EventsWithClient).
+// The subscription is removed once the context is cancelled.
+func (c *Client) MonitorSkew(ctx context.Context, clk clock.Clock, window int, report func(SkewReport)) error {
+	var (
+		clients = c.Clients()
+		indices = make(map[string]int, len(clients))
+		delays  = make([][]time.Duration, len(clients))
+		mu      sync.Mutex
+	)
+	for i, client := range clients {
+		indices[client.Address()] = i
+	}
+	subscriptionUUID, err := c.subscribe(ctx, []string{"head"}, func(client beacon.Client, e *v1.Event) {
+		head, ok := e.Data.(*v1.HeadEvent)
+		if !ok || ctx.Err() != nil {
+			return
+		}
+		i, ok := indices[client.Address()]
+		if !ok {
+			return
+		}
+		delay := -clk.AtSlot(head.Slot).Until()
+
+		mu.Lock()
+		defer mu.Unlock()
+		delays[i] = append(delays[i], delay)
+		if len(delays[i]) > window {
+			delays[i] = delays[i][1:]
+		}
+	})
+	if err != nil {
+		c.unsubscribe(subscriptionUUID)
+		return err
+	}
+	context.AfterFunc(ctx, func() { c.unsubscribe(subscriptionUUID) })
+
+	go func() {
+		for m := range clk.EveryInterval(ctx, 2, 3) {
+			r := SkewReport{Clients: make([]ClientSkew, len(clients))}
+			var wg sync.WaitGroup
+			for i, client := range clients {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					r.Clients[i] = c.clientSkew(ctx, client, m.Slot())
+				}()
+			}
+			wg.Wait()
+
+			mu.Lock()
+			var skews []time.Duration
+			for i := range r.Clients {
+				r.Clients[i].Samples = len(delays[i])
+				if len(delays[i]) > 0 {
+					r.Clients[i].Skew = slices.Min(delays[i])
+					skews = append(skews, r.Clients[i].Skew)
+				}
+			}
+			mu.Unlock()
+			if len(skews) > 0 {
+				slices.Sort(skews)
+				r.Skew = skews[len(skews)/2]
+			}
+
+			report(r)
+		}
+	}()
+	return nil
+}
+
+// clientSkew returns the ClientSkew of the given client's head block header
+// relative to the given slot.
+func (c *Client) clientSkew(ctx context.Context, client beacon.Client, slot phase0.Slot) ClientSkew {
+	skew := ClientSkew{Client: client}
+	skew.HeadSlot, skew.Err = c.headSlot(ctx, client)
+	if skew.Err == nil {
+		skew.HeadDistance = int64(slot) - int64(skew.HeadSlot)
+	}
+	return skew
+}
+
+// headSlot returns the slot of the given client's head block header.
+func (c *Client) headSlot(ctx context.Context, client beacon.Client) (phase0.Slot, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(c.scope.Timeout))
+	defer cancel()
+
+	resp, err := client.BeaconBlockHeader(ctx, &api.BeaconBlockHeaderOpts{Block: "head"})
+	if err != nil {
+		return 0, err
+	}
+	if resp == nil || resp.Data == nil || resp.Data.Header == nil || resp.Data.Header.Message == nil {
+		return 0, errors.New("empty head block header")
+	}
+	return resp.Data.Header.Message.Slot, nil
+}
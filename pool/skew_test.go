@@ -0,0 +1,98 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/ssvlabs/beacon-kit"
+	"github.com/ssvlabs/beacon-kit/clock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorSkew(t *testing.T) {
+	clk := clock.NewFake(beacon.Mainnet.ClockParams())
+	clk.JumpToSlot(5)
+
+	handlers := make([]api.EventHandlerFunc, 3)
+	streams := make([]context.Context, 3)
+	nodes := []*testNode{
+		{head: 5},
+		{head: 3},
+		{headErr: errors.New("connection refused")},
+	}
+	clients := make([]beacon.Client, len(nodes))
+	for i, node := range nodes {
+		client := CreateTestClient(TestClientKnobs{Address: fmt.Sprintf("http://node-%d", i), Node: node})
+		client.On("Events", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				streams[i] = args.Get(0).(context.Context)
+				handlers[i] = args.Get(1).(*api.EventsOpts).Handler
+			}).
+			Return(nil)
+		clients[i] = client
+	}
+	pool := New(clients)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reports := make(chan SkewReport)
+	require.NoError(t, pool.MonitorSkew(ctx, clk, 2, func(r SkewReport) {
+		reports <- r
+	}))
+	// Head events are subscribed to through the pool's subscriptions.
+	require.Len(t, pool.clientSubscriptions, 3)
+	head := func(slot phase0.Slot) *apiv1.Event {
+		return &apiv1.Event{Topic: "head", Data: &apiv1.HeadEvent{Slot: slot}}
+	}
+
+	// Client 0 receives the head 1s into the slot, and client 1 receives it 2s
+	// into the slot, after a late head of the previous slot. Old samples are
+	// forgotten after the window.
+	handlers[0](head(2))
+	clk.Advance(time.Second)
+	handlers[0](head(5))
+	clk.Advance(time.Second)
+	handlers[1](head(4))
+	handlers[1](head(5))
+	handlers[0](head(5))
+
+	clk.BlockUntilTickers(1)
+	clk.Set(clk.AtSlot(5).IntervalTime(2, 3))
+	report := <-reports
+
+	require.Len(t, report.Clients, 3)
+	require.Equal(t, time.Second, report.Clients[0].Skew)
+	require.Equal(t, 2, report.Clients[0].Samples)
+	require.Equal(t, phase0.Slot(5), report.Clients[0].HeadSlot)
+	require.Zero(t, report.Clients[0].HeadDistance)
+	require.Equal(t, 2*time.Second, report.Clients[1].Skew)
+	require.Equal(t, int64(2), report.Clients[1].HeadDistance)
+	require.Error(t, report.Clients[2].Err)
+	require.Zero(t, report.Clients[2].Samples)
+	require.Equal(t, 2*time.Second, report.Skew)
+
+	// A local clock which is behind has a negative skew.
+	handlers[2](head(6))
+	clk.BlockUntilTickers(1)
+	clk.Set(clk.AtSlot(6).IntervalTime(2, 3))
+	report = <-reports
+	require.Equal(t, -4*time.Second, report.Clients[2].Skew)
+	require.Equal(t, time.Second, report.Clients[0].Skew)
+	require.Equal(t, time.Second, report.Skew)
+
+	// Cancelling the context closes the event streams.
+	cancel()
+	for i, stream := range streams {
+		require.Eventually(t, func() bool { return stream.Err() != nil }, time.Second, time.Millisecond, "client %d", i)
+	}
+	pool.subscriptionsMu.RLock()
+	defer pool.subscriptionsMu.RUnlock()
+	require.Empty(t, pool.desiredSubscriptions)
+}
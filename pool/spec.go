@@ -0,0 +1,127 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ssvlabs/beacon-kit"
+)
+
+// ClientSpecReport is the result of comparing a client's Spec with the expected Spec.
+type ClientSpecReport struct {
+	Client beacon.Client
+	Diffs  []beacon.SpecDiff
+
+	// Err is set if the client's Spec could not be fetched or parsed.
+	Err error
+}
+
+// OK returns true if the client's Spec matches the expected Spec.
+func (r *ClientSpecReport) OK() bool {
+	return r.Err == nil && len(r.Diffs) == 0
+}
+
+func (r *ClientSpecReport) String() string {
+	switch {
+	case r.Err != nil:
+		return fmt.Sprintf("⨉ %s -> %s", r.Client.Address(), r.Err)
+	case len(r.Diffs) > 0:
+		var b strings.Builder
+		fmt.Fprintf(&b, "⨉ %s (%d differences)", r.Client.Address(), len(r.Diffs))
+		for _, diff := range r.Diffs {
+			b.WriteString("\n\t\t" + diff.String())
+		}
+		return b.String()
+	}
+	return fmt.Sprintf("✓ %s", r.Client.Address())
+}
+
+// SpecReport is the result of VerifySpec, with a ClientSpecReport
+// for each client in the pool.
+type SpecReport []ClientSpecReport
+
+// OK returns true if every client's Spec matches the expected Spec.
+func (r SpecReport) OK() bool {
+	for i := range r {
+		if !r[i].OK() {
+			return false
+		}
+	}
+	return true
+}
+
+func (r SpecReport) String() string {
+	if len(r) == 0 {
+		return "SpecReport{}"
+	}
+	var b strings.Builder
+	b.WriteString("SpecReport:")
+	for i := range r {
+		b.WriteString("\n\t" + r[i].String())
+	}
+	return b.String()
+}
+
+// SpecMismatchError is returned by VerifySpec in strict mode
+// when any client's Spec doesn't match the expected Spec.
+type SpecMismatchError struct {
+	Report SpecReport
+}
+
+func (e *SpecMismatchError) Error() string {
+	return e.Report.String()
+}
+
+// VerifySpec fetches the Spec and Genesis of every client in the pool and compares
+// them with the given Spec, returning the differences of each client. Each client
+// is called with the retries, Timeout and Deadline of the current Scope.
+//
+// In strict mode, a *SpecMismatchError is returned if any client differs or fails
+// to respond, which is meant to fail startup when pointed at the wrong network.
+func (c *Client) VerifySpec(ctx context.Context, spec *beacon.Spec, strict bool) (SpecReport, error) {
+	clients := c.Clients()
+	report := make(SpecReport, len(clients))
+
+	// Every client is called on its own, without comparing responses.
+	scope := c.scope
+	scope.Quorum = 0
+	scope.Divergence = nil
+
+	var wg sync.WaitGroup
+	for i, client := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report[i].Client = client
+
+			fetched := false
+			err := newCall(scope, []beacon.Client{client}, func(ctx context.Context, client beacon.Client) error {
+				actual, err := beacon.FetchSpec(ctx, client)
+				if err != nil {
+					return err
+				}
+				report[i].Diffs = spec.Diff(actual)
+				fetched = true
+				return nil
+			}).Do(ctx)
+			var callErr *Error
+			switch {
+			case errors.As(err, &callErr) && len(callErr.Trace) > 0:
+				// Report the error of the last attempt rather than the whole trace.
+				err = callErr.Trace[len(callErr.Trace)-1].Err
+			case err == nil && !fetched:
+				err = fmt.Errorf("failed to get spec: %w", context.Cause(ctx))
+			}
+			report[i].Err = err
+		}()
+	}
+	wg.Wait()
+
+	if strict && !report.OK() {
+		return report, &SpecMismatchError{Report: report}
+	}
+	return report, nil
+}
@@ -0,0 +1,82 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/ssvlabs/beacon-kit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySpec(t *testing.T) {
+	data, err := os.ReadFile("../testdata/config/hoodi.yaml")
+	require.NoError(t, err)
+	config, err := beacon.ParseConfigYAMLValues(data)
+	require.NoError(t, err)
+	hoodi, err := beacon.ParseConfigYAML(data, nil)
+	require.NoError(t, err)
+
+	hoodiGenesis := &apiv1.Genesis{
+		GenesisTime:           hoodi.GenesisTime,
+		GenesisValidatorsRoot: hoodi.GenesisValidatorsRoot,
+		GenesisForkVersion:    hoodi.GenesisForkVersion,
+	}
+	pool := New([]beacon.Client{
+		CreateTestClient(TestClientKnobs{Address: "http://hoodi-1", Spec: config, Genesis: hoodiGenesis}),
+		CreateTestClient(TestClientKnobs{Address: "http://hoodi-2", Spec: config, Genesis: hoodiGenesis}),
+	})
+
+	// Matching Spec.
+	report, err := pool.VerifySpec(context.Background(), hoodi, true)
+	require.NoError(t, err)
+	require.True(t, report.OK())
+	require.Len(t, report, 2)
+
+	// Mismatching Spec is reported, and fails in strict mode.
+	report, err = pool.VerifySpec(context.Background(), beacon.Holesky, false)
+	require.NoError(t, err)
+	require.False(t, report.OK())
+	for _, clientReport := range report {
+		require.NoError(t, clientReport.Err)
+		require.Contains(t, clientReport.Diffs, beacon.SpecDiff{
+			Field:    "GenesisForkVersion",
+			Expected: beacon.Holesky.GenesisForkVersion,
+			Actual:   hoodi.GenesisForkVersion,
+		})
+	}
+
+	_, err = pool.VerifySpec(context.Background(), beacon.Holesky, true)
+	var mismatchErr *SpecMismatchError
+	require.True(t, errors.As(err, &mismatchErr))
+	require.Len(t, mismatchErr.Report, 2)
+
+	// A failing client is reported.
+	pool = New([]beacon.Client{
+		CreateTestClient(TestClientKnobs{Address: "http://hoodi-1", Spec: config, Genesis: hoodiGenesis}),
+		CreateTestClient(TestClientKnobs{
+			Address: "http://down",
+			Err:     errors.New("connection refused"),
+		}),
+	})
+	report, err = pool.VerifySpec(context.Background(), hoodi, false)
+	require.NoError(t, err)
+	require.True(t, report[0].OK())
+	require.Error(t, report[1].Err)
+	_, err = pool.VerifySpec(context.Background(), hoodi, true)
+	require.True(t, errors.As(err, &mismatchErr))
+
+	// Clients are called with the Timeout of the Scope.
+	hanging := CreateTestClient(TestClientKnobs{Address: "http://hanging", MinSleep: time.Hour})
+	pool = New([]beacon.Client{
+		CreateTestClient(TestClientKnobs{Address: "http://hoodi-1", Spec: config, Genesis: hoodiGenesis}),
+		hanging,
+	}, Timeout(10*time.Millisecond), RetryEveryLimit(0, 0))
+	report, err = pool.VerifySpec(context.Background(), hoodi, false)
+	require.NoError(t, err)
+	require.True(t, report[0].OK())
+	require.ErrorIs(t, report[1].Err, context.DeadlineExceeded)
+}
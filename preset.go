@@ -0,0 +1,68 @@
+package beacon
+
+import (
+	"math"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// specConstants are values which are defined by the consensus specs
+// as constants, and are therefore absent from config.yaml files and
+// not served by every Beacon node implementation.
+var specConstants = map[string]interface{}{
+	"FAR_FUTURE_EPOCH":                         uint64(math.MaxUint64),
+	"TARGET_AGGREGATORS_PER_COMMITTEE":         uint64(16),
+	"TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE": uint64(16),
+	"SYNC_COMMITTEE_SUBNET_COUNT":              uint64(4),
+	"ATTESTATION_SUBNET_COUNT":                 uint64(64),
+	"ATTESTATION_PROPAGATION_SLOT_RANGE":       uint64(32),
+
+	"DOMAIN_BEACON_PROPOSER":                phase0.DomainType{0x00, 0x00, 0x00, 0x00},
+	"DOMAIN_BEACON_ATTESTER":                phase0.DomainType{0x01, 0x00, 0x00, 0x00},
+	"DOMAIN_RANDAO":                         phase0.DomainType{0x02, 0x00, 0x00, 0x00},
+	"DOMAIN_DEPOSIT":                        phase0.DomainType{0x03, 0x00, 0x00, 0x00},
+	"DOMAIN_VOLUNTARY_EXIT":                 phase0.DomainType{0x04, 0x00, 0x00, 0x00},
+	"DOMAIN_SELECTION_PROOF":                phase0.DomainType{0x05, 0x00, 0x00, 0x00},
+	"DOMAIN_AGGREGATE_AND_PROOF":            phase0.DomainType{0x06, 0x00, 0x00, 0x00},
+	"DOMAIN_SYNC_COMMITTEE":                 phase0.DomainType{0x07, 0x00, 0x00, 0x00},
+	"DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF": phase0.DomainType{0x08, 0x00, 0x00, 0x00},
+	"DOMAIN_CONTRIBUTION_AND_PROOF":         phase0.DomainType{0x09, 0x00, 0x00, 0x00},
+	"DOMAIN_APPLICATION_MASK":               phase0.DomainType{0x00, 0x00, 0x00, 0x01},
+	"DOMAIN_APPLICATION_BUILDER":            phase0.DomainType{0x00, 0x00, 0x00, 0x01},
+
+	// Networks which predate Fulu don't schedule it.
+	"FULU_FORK_VERSION": phase0.Version{},
+	"FULU_FORK_EPOCH":   uint64(math.MaxUint64),
+}
+
+// specPresets are the values of the consensus specs presets, keyed
+// by PRESET_BASE. Presets are compiled into Beacon node implementations
+// and are therefore absent from config.yaml files.
+var specPresets = map[string]map[string]interface{}{
+	"mainnet": {
+		"SLOTS_PER_EPOCH":                  uint64(32),
+		"MAX_COMMITTEES_PER_SLOT":          uint64(64),
+		"TARGET_COMMITTEE_SIZE":            uint64(128),
+		"SYNC_COMMITTEE_SIZE":              uint64(512),
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": uint64(256),
+		"SHUFFLE_ROUND_COUNT":              uint64(90),
+		"MAX_EFFECTIVE_BALANCE":            uint64(32_000_000_000),
+		"MAX_EFFECTIVE_BALANCE_ELECTRA":    uint64(2_048_000_000_000),
+		"EFFECTIVE_BALANCE_INCREMENT":      uint64(1_000_000_000),
+		"MAX_SEED_LOOKAHEAD":               uint64(4),
+		"MAX_DEPOSITS":                     uint64(16),
+	},
+	"minimal": {
+		"SLOTS_PER_EPOCH":                  uint64(8),
+		"MAX_COMMITTEES_PER_SLOT":          uint64(4),
+		"TARGET_COMMITTEE_SIZE":            uint64(4),
+		"SYNC_COMMITTEE_SIZE":              uint64(32),
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD": uint64(8),
+		"SHUFFLE_ROUND_COUNT":              uint64(10),
+		"MAX_EFFECTIVE_BALANCE":            uint64(32_000_000_000),
+		"MAX_EFFECTIVE_BALANCE_ELECTRA":    uint64(2_048_000_000_000),
+		"EFFECTIVE_BALANCE_INCREMENT":      uint64(1_000_000_000),
+		"MAX_SEED_LOOKAHEAD":               uint64(4),
+		"MAX_DEPOSITS":                     uint64(16),
+	},
+}
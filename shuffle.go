@@ -0,0 +1,172 @@
+package beacon
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ErrNoActiveValidators is returned when a committee or proposer
+// is computed from an empty set of validators.
+var ErrNoActiveValidators = errors.New("no active validators")
+
+// ErrNoEffectiveBalance is returned when a proposer is computed
+// from validators which all have a zero effective balance.
+var ErrNoEffectiveBalance = errors.New("no effective balance")
+
+// ComputeSeed returns the seed for the given domain type and epoch from the
+// RANDAO mix at epoch + EPOCHS_PER_HISTORICAL_VECTOR - MIN_SEED_LOOKAHEAD - 1.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_seed
+func ComputeSeed(domainType phase0.DomainType, epoch phase0.Epoch, randaoMix phase0.Root) phase0.Root {
+	var b [4 + 8 + 32]byte
+	copy(b[:], domainType[:])
+	binary.LittleEndian.PutUint64(b[4:], uint64(epoch))
+	copy(b[12:], randaoMix[:])
+	return sha256.Sum256(b[:])
+}
+
+// ProposerSeed returns the seed of the proposer at the given slot,
+// given the seed of its epoch for DomainBeaconProposer.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_beacon_proposer_index
+func ProposerSeed(epochSeed phase0.Root, slot phase0.Slot) phase0.Root {
+	var b [32 + 8]byte
+	copy(b[:], epochSeed[:])
+	binary.LittleEndian.PutUint64(b[32:], uint64(slot))
+	return sha256.Sum256(b[:])
+}
+
+// ComputeShuffledIndex returns the shuffled index corresponding to seed (and indexCount).
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_shuffled_index
+func (s *Spec) ComputeShuffledIndex(index, indexCount uint64, seed phase0.Root) (uint64, error) {
+	if index >= indexCount {
+		return 0, fmt.Errorf("index %d out of range for %d indices", index, indexCount)
+	}
+
+	var (
+		pivotInput  [32 + 1]byte
+		sourceInput [32 + 1 + 4]byte
+	)
+	copy(pivotInput[:], seed[:])
+	copy(sourceInput[:], seed[:])
+	for round := uint64(0); round < s.ShuffleRoundCount; round++ {
+		pivotInput[32] = byte(round)
+		pivotHash := sha256.Sum256(pivotInput[:])
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % indexCount
+
+		flip := (pivot + indexCount - index) % indexCount
+		position := max(index, flip)
+
+		sourceInput[32] = byte(round)
+		binary.LittleEndian.PutUint32(sourceInput[33:], uint32(position/256))
+		source := sha256.Sum256(sourceInput[:])
+		bit := (source[(position%256)/8] >> (position % 8)) & 1
+		if bit == 1 {
+			index = flip
+		}
+	}
+	return index, nil
+}
+
+// ComputeCommittee returns the committee at the given index out of count
+// committees, from the given active validator indices and seed.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_committee
+func (s *Spec) ComputeCommittee(indices []phase0.ValidatorIndex, seed phase0.Root, index, count uint64) ([]phase0.ValidatorIndex, error) {
+	if index >= count {
+		return nil, fmt.Errorf("committee index %d out of range for %d committees", index, count)
+	}
+	total := uint64(len(indices))
+	start := total * index / count
+	end := total * (index + 1) / count
+	committee := make([]phase0.ValidatorIndex, 0, end-start)
+	for i := start; i < end; i++ {
+		shuffled, err := s.ComputeShuffledIndex(i, total, seed)
+		if err != nil {
+			return nil, err
+		}
+		committee = append(committee, indices[shuffled])
+	}
+	return committee, nil
+}
+
+// BeaconCommittee returns the beacon committee at the given slot and committee index,
+// from the active validator indices of the slot's epoch and the epoch's seed
+// for DomainBeaconAttester.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_beacon_committee
+func (s *Spec) BeaconCommittee(activeIndices []phase0.ValidatorIndex, seed phase0.Root, slot phase0.Slot, committeeIndex phase0.CommitteeIndex) ([]phase0.ValidatorIndex, error) {
+	committeesAtSlot := s.CommitteesAtSlot(uint64(len(activeIndices)))
+	if uint64(committeeIndex) >= committeesAtSlot {
+		return nil, fmt.Errorf("committee index %d out of range for %d committees at slot %d", committeeIndex, committeesAtSlot, slot)
+	}
+	return s.ComputeCommittee(
+		activeIndices,
+		seed,
+		uint64(slot%s.SlotsPerEpoch)*committeesAtSlot+uint64(committeeIndex),
+		committeesAtSlot*uint64(s.SlotsPerEpoch),
+	)
+}
+
+// ComputeProposerIndex returns the proposer out of the given active validator indices,
+// sampled by effective balance, from the proposer's seed (see ProposerSeed).
+// The sampling from Electra onwards is used if Electra is active at the given epoch.
+// It returns ErrNoEffectiveBalance if every effective balance is zero, in which case
+// the sampling might never accept a candidate.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#modified-compute_proposer_index
+func (s *Spec) ComputeProposerIndex(
+	indices []phase0.ValidatorIndex,
+	effectiveBalance func(phase0.ValidatorIndex) phase0.Gwei,
+	seed phase0.Root,
+	epoch phase0.Epoch,
+) (phase0.ValidatorIndex, error) {
+	if len(indices) == 0 {
+		return 0, ErrNoActiveValidators
+	}
+	if !slices.ContainsFunc(indices, func(index phase0.ValidatorIndex) bool {
+		return effectiveBalance(index) > 0
+	}) {
+		return 0, ErrNoEffectiveBalance
+	}
+	electra := s.IsForkActive(spec.DataVersionElectra, epoch)
+
+	var (
+		total       = uint64(len(indices))
+		randomInput [32 + 8]byte
+		random      [32]byte
+	)
+	copy(randomInput[:], seed[:])
+	for i := uint64(0); ; i++ {
+		shuffled, err := s.ComputeShuffledIndex(i%total, total, seed)
+		if err != nil {
+			return 0, err
+		}
+		candidate := indices[shuffled]
+		balance := uint64(effectiveBalance(candidate))
+
+		if electra {
+			const maxRandomValue = 1<<16 - 1
+			if i%16 == 0 {
+				binary.LittleEndian.PutUint64(randomInput[32:], i/16)
+				random = sha256.Sum256(randomInput[:])
+			}
+			offset := i % 16 * 2
+			randomValue := uint64(binary.LittleEndian.Uint16(random[offset : offset+2]))
+			if balance*maxRandomValue >= uint64(s.MaxEffectiveBalanceElectra)*randomValue {
+				return candidate, nil
+			}
+		} else {
+			const maxRandomByte = 1<<8 - 1
+			if i%32 == 0 {
+				binary.LittleEndian.PutUint64(randomInput[32:], i/32)
+				random = sha256.Sum256(randomInput[:])
+			}
+			randomByte := uint64(random[i%32])
+			if balance*maxRandomByte >= uint64(s.MaxEffectiveBalance)*randomByte {
+				return candidate, nil
+			}
+		}
+	}
+}
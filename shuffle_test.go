@@ -0,0 +1,152 @@
+package beacon
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+// TestSpecComputeShuffledIndex runs the consensus-spec-tests shuffling suite
+// (tests/{mainnet,minimal}/phase0/shuffling/core/shuffle), where
+// mapping[i] = compute_shuffled_index(i, count, seed).
+func TestSpecComputeShuffledIndex(t *testing.T) {
+	minimal := *Mainnet
+	minimal.ShuffleRoundCount = 10
+	presets := map[string]*Spec{
+		"mainnet": Mainnet,
+		"minimal": &minimal,
+	}
+
+	for preset, spec := range presets {
+		paths, err := filepath.Glob(filepath.Join("testdata/consensus-spec-tests/tests", preset,
+			"phase0/shuffling/core/shuffle/*/mapping.yaml"))
+		require.NoError(t, err)
+		require.NotEmpty(t, paths, preset)
+
+		for _, path := range paths {
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			var vector struct {
+				Seed    string   `yaml:"seed"`
+				Count   uint64   `yaml:"count"`
+				Mapping []uint64 `yaml:"mapping"`
+			}
+			require.NoError(t, yaml.Unmarshal(data, &vector), path)
+			seedBytes, err := hex.DecodeString(strings.TrimPrefix(vector.Seed, "0x"))
+			require.NoError(t, err, path)
+			seed := phase0.Root(seedBytes)
+
+			mapping := make([]uint64, vector.Count)
+			for i := range mapping {
+				mapping[i], err = spec.ComputeShuffledIndex(uint64(i), vector.Count, seed)
+				require.NoError(t, err, path)
+			}
+			require.Equal(t, vector.Mapping, mapping, path)
+			_, err = spec.ComputeShuffledIndex(vector.Count, vector.Count, seed)
+			require.Error(t, err, path)
+		}
+	}
+}
+
+// testIndices returns the validator indices used by the committee and proposer tests,
+// and their effective balances before and after Electra.
+func testIndices() ([]phase0.ValidatorIndex, map[phase0.ValidatorIndex]phase0.Gwei, map[phase0.ValidatorIndex]phase0.Gwei) {
+	var (
+		indices         []phase0.ValidatorIndex
+		balances        = map[phase0.ValidatorIndex]phase0.Gwei{}
+		electraBalances = map[phase0.ValidatorIndex]phase0.Gwei{}
+	)
+	for i := phase0.ValidatorIndex(0); i < 300; i += 3 {
+		indices = append(indices, i)
+		balances[i] = []phase0.Gwei{32_000_000_000, 16_000_000_000, 31_000_000_000}[(i/3)%3]
+		electraBalances[i] = []phase0.Gwei{2_048_000_000_000, 32_000_000_000, 1_000_000_000_000}[(i/3)%3]
+	}
+	return indices, balances, electraBalances
+}
+
+// TestSpecComputeCommittee compares against compute_committee from the consensus specs:
+//
+//	indices = list(range(0, 300, 3))
+//	seed = hash(b'committee')
+//	[compute_committee(indices, seed, i, 7) for i in range(7)]
+func TestSpecComputeCommittee(t *testing.T) {
+	indices, _, _ := testIndices()
+	seed := phase0.Root(sha256.Sum256([]byte("committee")))
+	expected := [][]phase0.ValidatorIndex{
+		{231, 87, 291, 24, 66, 180, 69, 102, 135, 183, 120, 144, 90, 75},
+		{204, 174, 141, 12, 252, 51, 198, 261, 81, 279, 234, 258, 216, 165},
+		{9, 111, 285, 117, 225, 21, 267, 270, 147, 201, 39, 177, 297, 186},
+		{63, 276, 195, 30, 168, 264, 150, 273, 93, 228, 105, 219, 45, 108, 72},
+		{288, 114, 237, 0, 123, 42, 78, 159, 84, 36, 129, 54, 18, 192},
+		{162, 60, 96, 210, 6, 207, 243, 99, 294, 156, 57, 282, 240, 48},
+		{132, 189, 171, 126, 15, 249, 255, 33, 138, 153, 27, 3, 246, 213, 222},
+	}
+	for i, committee := range expected {
+		actual, err := Mainnet.ComputeCommittee(indices, seed, uint64(i), uint64(len(expected)))
+		require.NoError(t, err)
+		require.Equal(t, committee, actual, "committee %d", i)
+	}
+	_, err := Mainnet.ComputeCommittee(indices, seed, 7, 7)
+	require.Error(t, err)
+}
+
+func TestSpecBeaconCommittee(t *testing.T) {
+	// With 100 validators there is a single committee per slot,
+	// so the committee at slot n is the n-th out of SlotsPerEpoch.
+	indices, _, _ := testIndices()
+	seed := phase0.Root(sha256.Sum256([]byte("committee")))
+	for slot := phase0.Slot(0); slot < Mainnet.SlotsPerEpoch; slot++ {
+		expected, err := Mainnet.ComputeCommittee(indices, seed, uint64(slot), uint64(Mainnet.SlotsPerEpoch))
+		require.NoError(t, err)
+		actual, err := Mainnet.BeaconCommittee(indices, seed, Mainnet.StartSlot(10)+slot, 0)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	}
+	_, err := Mainnet.BeaconCommittee(indices, seed, 0, 1)
+	require.Error(t, err)
+}
+
+// TestSpecComputeProposerIndex compares against compute_proposer_index from the
+// phase0 and Electra consensus specs, with the indices and balances of testIndices
+// and seed = hash(bytes([slot]) * 32) for slot in range(8).
+func TestSpecComputeProposerIndex(t *testing.T) {
+	indices, balances, electraBalances := testIndices()
+	expected := []phase0.ValidatorIndex{276, 138, 288, 150, 9, 126, 105, 39}
+	expectedElectra := []phase0.ValidatorIndex{279, 216, 288, 150, 9, 126, 36, 261}
+
+	for slot := range expected {
+		var seedInput [32]byte
+		for i := range seedInput {
+			seedInput[i] = byte(slot)
+		}
+		seed := phase0.Root(sha256.Sum256(seedInput[:]))
+
+		proposer, err := Mainnet.ComputeProposerIndex(indices, func(index phase0.ValidatorIndex) phase0.Gwei {
+			return balances[index]
+		}, seed, Mainnet.ElectraForkEpoch-1)
+		require.NoError(t, err)
+		require.Equal(t, expected[slot], proposer, "slot %d", slot)
+
+		proposer, err = Mainnet.ComputeProposerIndex(indices, func(index phase0.ValidatorIndex) phase0.Gwei {
+			return electraBalances[index]
+		}, seed, Mainnet.ElectraForkEpoch)
+		require.NoError(t, err)
+		require.Equal(t, expectedElectra[slot], proposer, "slot %d", slot)
+	}
+
+	_, err := Mainnet.ComputeProposerIndex(nil, nil, phase0.Root{}, 0)
+	require.ErrorIs(t, err, ErrNoActiveValidators)
+
+	_, err = Mainnet.ComputeProposerIndex(indices, func(phase0.ValidatorIndex) phase0.Gwei {
+		return 0
+	}, phase0.Root{}, 0)
+	require.ErrorIs(t, err, ErrNoEffectiveBalance)
+}
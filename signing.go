@@ -0,0 +1,97 @@
+package beacon
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// HashRooter is implemented by SSZ objects which can be signed.
+type HashRooter interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
+// ComputeForkDataRoot returns the root of the ForkData for the given
+// fork version and genesis validators root.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_fork_data_root
+func ComputeForkDataRoot(version phase0.Version, genesisValidatorsRoot phase0.Root) (phase0.Root, error) {
+	root, err := (&phase0.ForkData{
+		CurrentVersion:        version,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}).HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, fmt.Errorf("failed to compute fork data root: %w", err)
+	}
+	return root, nil
+}
+
+// ComputeDomain returns the domain for the given domain type, fork version
+// and genesis validators root.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_domain
+func ComputeDomain(domainType phase0.DomainType, version phase0.Version, genesisValidatorsRoot phase0.Root) (phase0.Domain, error) {
+	forkDataRoot, err := ComputeForkDataRoot(version, genesisValidatorsRoot)
+	if err != nil {
+		return phase0.Domain{}, err
+	}
+	var domain phase0.Domain
+	copy(domain[:], domainType[:])
+	copy(domain[len(domainType):], forkDataRoot[:])
+	return domain, nil
+}
+
+// ComputeSigningRoot returns the root which is signed for the given
+// object in the given domain.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_signing_root
+func ComputeSigningRoot(object HashRooter, domain phase0.Domain) (phase0.Root, error) {
+	objectRoot, err := object.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, fmt.Errorf("failed to compute object root: %w", err)
+	}
+	root, err := (&phase0.SigningData{
+		ObjectRoot: objectRoot,
+		Domain:     domain,
+	}).HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, fmt.Errorf("failed to compute signing root: %w", err)
+	}
+	return root, nil
+}
+
+// Domain returns the domain for the given domain type at the given epoch,
+// using the fork version active at that epoch and the genesis validators root.
+//
+// Deposits and builder applications are domained to the genesis fork version and
+// an empty genesis validators root, so that they are valid across forks.
+// Voluntary exits are domained to the Capella fork version once Deneb is active at
+// the given epoch, since exits are verified with it from Deneb onwards whatever their
+// epoch, so exits which are verified from Deneb onwards should be domained at the
+// current epoch rather than their own. See https://eips.ethereum.org/EIPS/eip-7044
+func (s *Spec) Domain(domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Domain, error) {
+	switch {
+	case domainType == s.DomainDeposit || domainType == s.DomainApplicationBuilder:
+		return ComputeDomain(domainType, s.GenesisForkVersion, phase0.Root{})
+	case domainType == s.DomainVoluntaryExit && s.IsForkActive(spec.DataVersionDeneb, epoch):
+		return ComputeDomain(domainType, s.CapellaForkVersion, s.GenesisValidatorsRoot)
+	}
+	return ComputeDomain(domainType, s.ForkVersionAtEpoch(epoch), s.GenesisValidatorsRoot)
+}
+
+// GenesisDomain returns the domain for the given domain type at genesis.
+// Unlike Domain, it ignores any forks which are scheduled at genesis.
+func (s *Spec) GenesisDomain(domainType phase0.DomainType) (phase0.Domain, error) {
+	if domainType == s.DomainDeposit || domainType == s.DomainApplicationBuilder {
+		return ComputeDomain(domainType, s.GenesisForkVersion, phase0.Root{})
+	}
+	return ComputeDomain(domainType, s.GenesisForkVersion, s.GenesisValidatorsRoot)
+}
+
+// SigningRoot returns the root which is signed for the given object
+// with the given domain type at the given epoch.
+func (s *Spec) SigningRoot(object HashRooter, domainType phase0.DomainType, epoch phase0.Epoch) (phase0.Root, error) {
+	domain, err := s.Domain(domainType, epoch)
+	if err != nil {
+		return phase0.Root{}, err
+	}
+	return ComputeSigningRoot(object, domain)
+}
@@ -0,0 +1,89 @@
+package beacon
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// forkDataRoot computes hash_tree_root(ForkData) by hand, since
+// ForkData is a container of two chunks.
+func forkDataRoot(version phase0.Version, genesisValidatorsRoot phase0.Root) phase0.Root {
+	var chunks [64]byte
+	copy(chunks[:], version[:])
+	copy(chunks[32:], genesisValidatorsRoot[:])
+	return sha256.Sum256(chunks[:])
+}
+
+func TestComputeDomain(t *testing.T) {
+	// Well-known deposit domain on mainnet.
+	domain, err := Mainnet.Domain(Mainnet.DomainDeposit, 0)
+	require.NoError(t, err)
+	require.Equal(t, "03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9", hex.EncodeToString(domain[:]))
+
+	// Deposit and builder domains are independent of forks.
+	for _, domainType := range []phase0.DomainType{Mainnet.DomainDeposit, Mainnet.DomainApplicationBuilder} {
+		atGenesis, err := Mainnet.GenesisDomain(domainType)
+		require.NoError(t, err)
+		atElectra, err := Mainnet.Domain(domainType, Mainnet.ElectraForkEpoch)
+		require.NoError(t, err)
+		require.Equal(t, atGenesis, atElectra)
+	}
+}
+
+func TestSpecDomain(t *testing.T) {
+	tests := []struct {
+		spec       *Spec
+		domainType phase0.DomainType
+		epoch      phase0.Epoch
+		version    phase0.Version
+	}{
+		{Hoodi, Hoodi.DomainBeaconAttester, 0, Hoodi.DenebForkVersion},
+		{Hoodi, Hoodi.DomainBeaconAttester, Hoodi.ElectraForkEpoch, Hoodi.ElectraForkVersion},
+		{Hoodi, Hoodi.DomainBeaconProposer, Hoodi.FuluForkEpoch + 1, Hoodi.FuluForkVersion},
+		{Hoodi, Hoodi.DomainVoluntaryExit, 0, Hoodi.CapellaForkVersion},
+		{Hoodi, Hoodi.DomainVoluntaryExit, Hoodi.FuluForkEpoch, Hoodi.CapellaForkVersion},
+		// Exits are domained to their own epoch until Deneb is active.
+		{Mainnet, Mainnet.DomainVoluntaryExit, Mainnet.AltairForkEpoch, Mainnet.AltairForkVersion},
+		{Mainnet, Mainnet.DomainVoluntaryExit, Mainnet.DenebForkEpoch - 1, Mainnet.CapellaForkVersion},
+		{Mainnet, Mainnet.DomainVoluntaryExit, Mainnet.DenebForkEpoch, Mainnet.CapellaForkVersion},
+		{Mainnet, Mainnet.DomainVoluntaryExit, Mainnet.ElectraForkEpoch, Mainnet.CapellaForkVersion},
+	}
+	for _, test := range tests {
+		root := forkDataRoot(test.version, test.spec.GenesisValidatorsRoot)
+		var expected phase0.Domain
+		copy(expected[:], test.domainType[:])
+		copy(expected[4:], root[:28])
+
+		domain, err := test.spec.Domain(test.domainType, test.epoch)
+		require.NoError(t, err)
+		require.Equal(t, expected, domain, "%s: %#x at epoch %d", test.spec.Network, test.domainType, test.epoch)
+	}
+
+	// GenesisDomain ignores forks scheduled at genesis.
+	domain, err := Hoodi.GenesisDomain(Hoodi.DomainBeaconAttester)
+	require.NoError(t, err)
+	root := forkDataRoot(Hoodi.GenesisForkVersion, Hoodi.GenesisValidatorsRoot)
+	require.Equal(t, root[:28], domain[4:])
+}
+
+func TestSpecSigningRoot(t *testing.T) {
+	checkpoint := &phase0.Checkpoint{Epoch: 1234, Root: phase0.Root{0x01, 0x02}}
+	objectRoot, err := checkpoint.HashTreeRoot()
+	require.NoError(t, err)
+
+	domain, err := Mainnet.Domain(Mainnet.DomainBeaconAttester, 1234)
+	require.NoError(t, err)
+
+	var chunks [64]byte
+	copy(chunks[:], objectRoot[:])
+	copy(chunks[32:], domain[:])
+	expected := phase0.Root(sha256.Sum256(chunks[:]))
+
+	root, err := Mainnet.SigningRoot(checkpoint, Mainnet.DomainBeaconAttester, 1234)
+	require.NoError(t, err)
+	require.Equal(t, expected, root)
+}
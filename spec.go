@@ -1,21 +1,27 @@
 package beacon
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/ssvlabs/beacon-kit/clock"
 )
 
+// ErrInvalidSpec is returned when a Spec has inconsistent values.
+var ErrInvalidSpec = errors.New("invalid spec")
+
 // Spec contains the network-specific Beacon Chain configuration
 // and provides helper methods to access it.
 type Spec struct {
 	Network     Network
 	GenesisTime time.Time
 
-	GenesisSlot        phase0.Slot
-	GenesisForkVersion phase0.Version
-	FarFutureEpoch     phase0.Epoch
+	GenesisSlot           phase0.Slot
+	GenesisForkVersion    phase0.Version
+	GenesisValidatorsRoot phase0.Root
+	FarFutureEpoch        phase0.Epoch
 
 	SlotsPerEpoch  phase0.Slot
 	SecondsPerSlot uint64
@@ -24,6 +30,20 @@ type Spec struct {
 	TargetCommitteeSize           uint64
 	TargetAggregatorsPerCommittee uint64
 	AttestationSubnetCount        uint64
+	ShuffleRoundCount             uint64
+
+	MaxEffectiveBalance        phase0.Gwei
+	MaxEffectiveBalanceElectra phase0.Gwei
+	EffectiveBalanceIncrement  phase0.Gwei
+
+	MaxSeedLookahead                    phase0.Epoch
+	MinValidatorWithdrawabilityDelay    phase0.Epoch
+	MaxDeposits                         uint64
+	MinPerEpochChurnLimit               uint64
+	ChurnLimitQuotient                  uint64
+	MaxPerEpochActivationChurnLimit     uint64
+	MinPerEpochChurnLimitElectra        phase0.Gwei
+	MaxPerEpochActivationExitChurnLimit phase0.Gwei
 
 	// AttestationPropagationSlotRange is the maximum number of slots
 	// during which an attestation can be propagated, after which
@@ -48,16 +68,100 @@ type Spec struct {
 	DomainApplicationMask             phase0.DomainType
 	DomainApplicationBuilder          phase0.DomainType
 
-	AltairForkEpoch    phase0.Epoch
-	BellatrixForkEpoch phase0.Epoch
+	AltairForkVersion    phase0.Version
+	AltairForkEpoch      phase0.Epoch
+	BellatrixForkVersion phase0.Version
+	BellatrixForkEpoch   phase0.Epoch
+	CapellaForkVersion   phase0.Version
+	CapellaForkEpoch     phase0.Epoch
+	DenebForkVersion     phase0.Version
+	DenebForkEpoch       phase0.Epoch
+	ElectraForkVersion   phase0.Version
+	ElectraForkEpoch     phase0.Epoch
+	FuluForkVersion      phase0.Version
+	FuluForkEpoch        phase0.Epoch
+}
+
+// Validate returns an error wrapping ErrInvalidSpec if the Spec
+// has missing or inconsistent values.
+func (s *Spec) Validate() error {
+	switch {
+	case s.SlotsPerEpoch == 0:
+		return fmt.Errorf("%w: SlotsPerEpoch is zero", ErrInvalidSpec)
+	case s.SecondsPerSlot == 0:
+		return fmt.Errorf("%w: SecondsPerSlot is zero", ErrInvalidSpec)
+	case s.MaxCommitteesPerSlot == 0:
+		return fmt.Errorf("%w: MaxCommitteesPerSlot is zero", ErrInvalidSpec)
+	case s.TargetCommitteeSize == 0:
+		return fmt.Errorf("%w: TargetCommitteeSize is zero", ErrInvalidSpec)
+	case s.TargetAggregatorsPerCommittee == 0:
+		return fmt.Errorf("%w: TargetAggregatorsPerCommittee is zero", ErrInvalidSpec)
+	case s.TargetAggregatorsPerSyncSubcommittee == 0:
+		return fmt.Errorf("%w: TargetAggregatorsPerSyncSubcommittee is zero", ErrInvalidSpec)
+	case s.AttestationSubnetCount == 0:
+		return fmt.Errorf("%w: AttestationSubnetCount is zero", ErrInvalidSpec)
+	case s.SyncCommitteeSubnetCount == 0:
+		return fmt.Errorf("%w: SyncCommitteeSubnetCount is zero", ErrInvalidSpec)
+	case s.SyncCommitteeSize%s.SyncCommitteeSubnetCount != 0:
+		return fmt.Errorf("%w: SyncCommitteeSize (%d) is not divisible by SyncCommitteeSubnetCount (%d)",
+			ErrInvalidSpec, s.SyncCommitteeSize, s.SyncCommitteeSubnetCount)
+	case s.EpochsPerSyncCommitteePeriod == 0:
+		return fmt.Errorf("%w: EpochsPerSyncCommitteePeriod is zero", ErrInvalidSpec)
+	case s.GenesisSlot%s.SlotsPerEpoch != 0:
+		return fmt.Errorf("%w: GenesisSlot (%d) is not the start of an epoch", ErrInvalidSpec, s.GenesisSlot)
+	case s.ChurnLimitQuotient == 0:
+		return fmt.Errorf("%w: ChurnLimitQuotient is zero", ErrInvalidSpec)
+	case s.EffectiveBalanceIncrement == 0:
+		return fmt.Errorf("%w: EffectiveBalanceIncrement is zero", ErrInvalidSpec)
+	case s.MaxEffectiveBalance == 0:
+		return fmt.Errorf("%w: MaxEffectiveBalance is zero", ErrInvalidSpec)
+	case s.MaxDeposits == 0:
+		return fmt.Errorf("%w: MaxDeposits is zero", ErrInvalidSpec)
+	case s.MinPerEpochChurnLimit == 0:
+		return fmt.Errorf("%w: MinPerEpochChurnLimit is zero", ErrInvalidSpec)
+	case s.MaxPerEpochActivationChurnLimit == 0:
+		return fmt.Errorf("%w: MaxPerEpochActivationChurnLimit is zero", ErrInvalidSpec)
+	case s.MinPerEpochChurnLimitElectra < s.EffectiveBalanceIncrement:
+		return fmt.Errorf("%w: MinPerEpochChurnLimitElectra (%d) is less than EffectiveBalanceIncrement (%d)",
+			ErrInvalidSpec, s.MinPerEpochChurnLimitElectra, s.EffectiveBalanceIncrement)
+	case s.MaxPerEpochActivationExitChurnLimit == 0:
+		return fmt.Errorf("%w: MaxPerEpochActivationExitChurnLimit is zero", ErrInvalidSpec)
+	}
+
+	forks := s.Forks()
+	for i := 1; i < len(forks); i++ {
+		if forks[i].Epoch < forks[i-1].Epoch {
+			return fmt.Errorf("%w: %s fork epoch (%d) is before %s fork epoch (%d)",
+				ErrInvalidSpec, forks[i].DataVersion, forks[i].Epoch, forks[i-1].DataVersion, forks[i-1].Epoch)
+		}
+		if forks[i].Epoch == s.FarFutureEpoch {
+			continue
+		}
+		for _, prev := range forks[:i] {
+			if forks[i].Version == prev.Version {
+				return fmt.Errorf("%w: %s and %s fork versions are both %#x",
+					ErrInvalidSpec, prev.DataVersion, forks[i].DataVersion, forks[i].Version)
+			}
+		}
+	}
+	return nil
 }
 
 func (s *Spec) Clock() clock.Clock {
-	return clock.New(clock.Params{
-		GenesisTime:   s.GenesisTime,
-		SlotsPerEpoch: s.SlotsPerEpoch,
-		SlotDuration:  s.SlotDuration(),
-	})
+	return clock.New(s.ClockParams())
+}
+
+// ClockParams returns the clock.Params of the Spec, which can be
+// customized, such as with a clock.TimeSource, before creating a Clock.
+func (s *Spec) ClockParams() clock.Params {
+	return clock.Params{
+		GenesisTime:                     s.GenesisTime,
+		GenesisSlot:                     s.GenesisSlot,
+		SlotsPerEpoch:                   s.SlotsPerEpoch,
+		SlotDuration:                    s.SlotDuration(),
+		EpochsPerSyncCommitteePeriod:    s.EpochsPerSyncCommitteePeriod,
+		AttestationPropagationSlotRange: s.AttestationPropagationSlotRange,
+	}
 }
 
 // SlotDuration returns the time.Duration of a slot.
@@ -66,21 +170,34 @@ func (s *Spec) SlotDuration() time.Duration {
 }
 
 // TimeAtSlot returns the time at the start of the given slot.
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/bellatrix/beacon-chain.md#compute_time_at_slot
 func (s *Spec) TimeAtSlot(slot phase0.Slot) time.Time {
-	return s.GenesisTime.Add(time.Duration(slot) * s.SlotDuration())
+	slotsSinceGenesis := int64(slot) - int64(s.GenesisSlot)
+	return s.GenesisTime.Add(time.Duration(slotsSinceGenesis) * s.SlotDuration())
 }
 
-// SlotAtTime returns the slot at the given time.
+// SlotAtTime returns the slot at the given time, or GenesisSlot
+// if the given time is before genesis.
 func (s *Spec) SlotAtTime(t time.Time) phase0.Slot {
-	return phase0.Slot(t.Sub(s.GenesisTime) / s.SlotDuration())
+	if t.Before(s.GenesisTime) {
+		return s.GenesisSlot
+	}
+	return s.GenesisSlot + phase0.Slot(t.Sub(s.GenesisTime)/s.SlotDuration())
+}
+
+// GenesisEpoch returns the epoch of GenesisSlot.
+func (s *Spec) GenesisEpoch() phase0.Epoch {
+	return s.EpochFromSlot(s.GenesisSlot)
 }
 
-// EpochFromSlot returns the epoch at the given slot.
+// EpochFromSlot returns the epoch at the given slot. Slots and epochs are
+// counted from zero regardless of GenesisSlot, which is the start of GenesisEpoch.
 func (s *Spec) EpochFromSlot(slot phase0.Slot) phase0.Epoch {
 	return phase0.Epoch(slot / s.SlotsPerEpoch)
 }
 
-// StartSlot returns the first slot in the given epoch.
+// StartSlot returns the first slot in the given epoch. Slots and epochs are
+// counted from zero regardless of GenesisSlot, which is the start of GenesisEpoch.
 func (s *Spec) StartSlot(epoch phase0.Epoch) phase0.Slot {
 	return phase0.Slot(epoch) * s.SlotsPerEpoch
 }
@@ -103,6 +220,8 @@ func (s *Spec) CommitteesAtSlot(activeValidators uint64) uint64 {
 }
 
 // AttestationSubnetID returns the subnet ID for an attestation.
+// From Electra onwards, AttestationData.Index is 0 and committeeIndex must be
+// taken from committee_bits instead (see VersionedAttestationSubnetID).
 // See https://github.com/ethereum/consensus-specs/blob/395fdd456657482b7257c8b9a9d68bea68917aaf/specs/phase0/validator.md#broadcast-attestation
 func (s *Spec) AttestationSubnetID(slot phase0.Slot, committeeIndex phase0.CommitteeIndex, committeesAtSlot uint64) SubnetID {
 	slotsSinceEpochStart := slot % s.SlotsPerEpoch
@@ -115,6 +234,27 @@ func (s *Spec) SyncCommitteeSubnetID(index phase0.CommitteeIndex) SubnetID {
 	return SubnetID(index) / SubnetID(s.SyncSubcommitteeSize())
 }
 
+// SyncCommitteePeriod returns the sync committee period of the given epoch.
+func (s *Spec) SyncCommitteePeriod(epoch phase0.Epoch) uint64 {
+	return uint64(epoch / s.EpochsPerSyncCommitteePeriod)
+}
+
+// SyncCommitteePeriodStartEpoch returns the first epoch in the given sync committee period.
+func (s *Spec) SyncCommitteePeriodStartEpoch(period uint64) phase0.Epoch {
+	return phase0.Epoch(period) * s.EpochsPerSyncCommitteePeriod
+}
+
+// SyncCommitteePeriodEndEpoch returns the last epoch in the given sync committee period.
+func (s *Spec) SyncCommitteePeriodEndEpoch(period uint64) phase0.Epoch {
+	return s.SyncCommitteePeriodStartEpoch(period+1) - 1
+}
+
+// IsLastSlotOfSyncCommitteePeriod returns true if the given slot is the last
+// slot before the sync committee period changes.
+func (s *Spec) IsLastSlotOfSyncCommitteePeriod(slot phase0.Slot) bool {
+	return s.SyncCommitteePeriod(s.EpochFromSlot(slot)) != s.SyncCommitteePeriod(s.EpochFromSlot(slot+1))
+}
+
 // SyncSubcommitteeSize returns the size of a sync subcommittee.
 func (s *Spec) SyncSubcommitteeSize() uint64 {
 	return s.SyncCommitteeSize / s.SyncCommitteeSubnetCount
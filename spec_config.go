@@ -0,0 +1,362 @@
+package beacon
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// ErrSpecKeyMissing is returned when a required key is missing from the spec.
+	ErrSpecKeyMissing = errors.New("missing key")
+
+	// ErrSpecKeyMalformed is returned when a key in the spec has an unexpected value.
+	ErrSpecKeyMalformed = errors.New("malformed value")
+
+	// ErrUnknownGenesis is returned when parsing the spec of a network whose genesis
+	// isn't given and which isn't registered (see RegisterNetwork).
+	ErrUnknownGenesis = errors.New("unknown genesis")
+)
+
+// SpecKeyError is returned when a key of a spec configuration
+// could not be parsed into a Spec.
+type SpecKeyError struct {
+	Key   string
+	Value interface{}
+	Err   error
+}
+
+func (e *SpecKeyError) Error() string {
+	if e.Value == nil {
+		return fmt.Sprintf("spec key %s: %s", e.Key, e.Err)
+	}
+	return fmt.Sprintf("spec key %s: %s (%v)", e.Key, e.Err, e.Value)
+}
+
+func (e *SpecKeyError) Unwrap() error {
+	return e.Err
+}
+
+// SpecProvider provides the responses required to build a Spec.
+type SpecProvider interface {
+	eth2client.SpecProvider
+	eth2client.GenesisProvider
+}
+
+// FetchSpec builds a Spec from the Spec and Genesis responses of the given client.
+func FetchSpec(ctx context.Context, client SpecProvider) (*Spec, error) {
+	specResp, err := client.Spec(ctx, &api.SpecOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spec: %w", err)
+	}
+	if specResp == nil || specResp.Data == nil {
+		return nil, errors.New("failed to get spec: empty response")
+	}
+	genesisResp, err := client.Genesis(ctx, &api.GenesisOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get genesis: %w", err)
+	}
+	if genesisResp == nil || genesisResp.Data == nil {
+		return nil, errors.New("failed to get genesis: empty response")
+	}
+	return ParseSpec(specResp.Data, genesisResp.Data)
+}
+
+// ParseSpec builds a Spec from a spec configuration, such as the response
+// of /eth/v1/config/spec, and the genesis of the network.
+//
+// Values may either be raw strings (as served by the Beacon API) or the
+// typed values produced by go-eth2-client. Keys which are absent fall back
+// to the preset named by PRESET_BASE and then to the spec constants.
+//
+// The genesis time and genesis validators root which genesis lacks, or all of
+// genesis if it's nil, are taken from the registered Network named by
+// CONFIG_NAME, and ErrUnknownGenesis is returned if there's none.
+func ParseSpec(config map[string]interface{}, genesis *apiv1.Genesis) (*Spec, error) {
+	p := &specParser{config: config}
+	if base := p.optionalString("PRESET_BASE"); base != "" {
+		preset, ok := specPresets[base]
+		if !ok {
+			return nil, &SpecKeyError{Key: "PRESET_BASE", Value: base, Err: ErrSpecKeyMalformed}
+		}
+		p.preset = preset
+	}
+	genesis, err := resolveGenesis(Network(p.optionalString("CONFIG_NAME")), genesis)
+	if err != nil {
+		return nil, err
+	}
+	spec := &Spec{
+		Network:     Network(p.optionalString("CONFIG_NAME")),
+		GenesisTime: genesis.GenesisTime,
+
+		GenesisForkVersion:    genesis.GenesisForkVersion,
+		GenesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+		FarFutureEpoch:        p.epoch("FAR_FUTURE_EPOCH"),
+
+		SlotsPerEpoch:  phase0.Slot(p.uint64("SLOTS_PER_EPOCH")),
+		SecondsPerSlot: p.seconds("SECONDS_PER_SLOT"),
+
+		MaxCommitteesPerSlot:          p.uint64("MAX_COMMITTEES_PER_SLOT"),
+		TargetCommitteeSize:           p.uint64("TARGET_COMMITTEE_SIZE"),
+		TargetAggregatorsPerCommittee: p.uint64("TARGET_AGGREGATORS_PER_COMMITTEE"),
+		AttestationSubnetCount:        p.uint64("ATTESTATION_SUBNET_COUNT"),
+		ShuffleRoundCount:             p.uint64("SHUFFLE_ROUND_COUNT"),
+
+		MaxEffectiveBalance:        p.gwei("MAX_EFFECTIVE_BALANCE"),
+		MaxEffectiveBalanceElectra: p.gwei("MAX_EFFECTIVE_BALANCE_ELECTRA"),
+		EffectiveBalanceIncrement:  p.gwei("EFFECTIVE_BALANCE_INCREMENT"),
+
+		MaxSeedLookahead:                    p.epoch("MAX_SEED_LOOKAHEAD"),
+		MinValidatorWithdrawabilityDelay:    p.epoch("MIN_VALIDATOR_WITHDRAWABILITY_DELAY"),
+		MaxDeposits:                         p.uint64("MAX_DEPOSITS"),
+		MinPerEpochChurnLimit:               p.uint64("MIN_PER_EPOCH_CHURN_LIMIT"),
+		ChurnLimitQuotient:                  p.uint64("CHURN_LIMIT_QUOTIENT"),
+		MaxPerEpochActivationChurnLimit:     p.uint64("MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT"),
+		MinPerEpochChurnLimitElectra:        p.gwei("MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA"),
+		MaxPerEpochActivationExitChurnLimit: p.gwei("MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT"),
+
+		AttestationPropagationSlotRange: phase0.Slot(p.uint64("ATTESTATION_PROPAGATION_SLOT_RANGE")),
+
+		SyncCommitteeSize:                    p.uint64("SYNC_COMMITTEE_SIZE"),
+		TargetAggregatorsPerSyncSubcommittee: p.uint64("TARGET_AGGREGATORS_PER_SYNC_SUBCOMMITTEE"),
+		SyncCommitteeSubnetCount:             p.uint64("SYNC_COMMITTEE_SUBNET_COUNT"),
+		EpochsPerSyncCommitteePeriod:         p.epoch("EPOCHS_PER_SYNC_COMMITTEE_PERIOD"),
+
+		DomainBeaconProposer:              p.domainType("DOMAIN_BEACON_PROPOSER"),
+		DomainBeaconAttester:              p.domainType("DOMAIN_BEACON_ATTESTER"),
+		DomainRandao:                      p.domainType("DOMAIN_RANDAO"),
+		DomainDeposit:                     p.domainType("DOMAIN_DEPOSIT"),
+		DomainVoluntaryExit:               p.domainType("DOMAIN_VOLUNTARY_EXIT"),
+		DomainSelectionProof:              p.domainType("DOMAIN_SELECTION_PROOF"),
+		DomainAggregateAndProof:           p.domainType("DOMAIN_AGGREGATE_AND_PROOF"),
+		DomainSyncCommittee:               p.domainType("DOMAIN_SYNC_COMMITTEE"),
+		DomainSyncCommitteeSelectionProof: p.domainType("DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF"),
+		DomainContributionAndProof:        p.domainType("DOMAIN_CONTRIBUTION_AND_PROOF"),
+		DomainApplicationMask:             p.domainType("DOMAIN_APPLICATION_MASK"),
+		DomainApplicationBuilder:          p.domainType("DOMAIN_APPLICATION_BUILDER"),
+
+		AltairForkVersion:    p.version("ALTAIR_FORK_VERSION"),
+		AltairForkEpoch:      p.epoch("ALTAIR_FORK_EPOCH"),
+		BellatrixForkVersion: p.version("BELLATRIX_FORK_VERSION"),
+		BellatrixForkEpoch:   p.epoch("BELLATRIX_FORK_EPOCH"),
+		CapellaForkVersion:   p.version("CAPELLA_FORK_VERSION"),
+		CapellaForkEpoch:     p.epoch("CAPELLA_FORK_EPOCH"),
+		DenebForkVersion:     p.version("DENEB_FORK_VERSION"),
+		DenebForkEpoch:       p.epoch("DENEB_FORK_EPOCH"),
+		ElectraForkVersion:   p.version("ELECTRA_FORK_VERSION"),
+		ElectraForkEpoch:     p.epoch("ELECTRA_FORK_EPOCH"),
+		FuluForkVersion:      p.version("FULU_FORK_VERSION"),
+		FuluForkEpoch:        p.epoch("FULU_FORK_EPOCH"),
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	if spec.GenesisForkVersion == (phase0.Version{}) {
+		spec.GenesisForkVersion = p.version("GENESIS_FORK_VERSION")
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// resolveGenesis returns the given genesis with the genesis time and genesis
+// validators root which it lacks taken from the registered Network of the given
+// name, or ErrUnknownGenesis if they're unknown.
+func resolveGenesis(network Network, genesis *apiv1.Genesis) (*apiv1.Genesis, error) {
+	resolved := apiv1.Genesis{}
+	if genesis != nil {
+		resolved = *genesis
+	}
+	if !resolved.GenesisTime.IsZero() && resolved.GenesisValidatorsRoot != (phase0.Root{}) {
+		return &resolved, nil
+	}
+	known, ok := LookupNetwork(network)
+	if !ok {
+		return nil, fmt.Errorf("%w: network %q isn't registered", ErrUnknownGenesis, network)
+	}
+	if resolved.GenesisTime.IsZero() {
+		resolved.GenesisTime = known.GenesisTime
+	}
+	if resolved.GenesisValidatorsRoot == (phase0.Root{}) {
+		resolved.GenesisValidatorsRoot = known.GenesisValidatorsRoot
+	}
+	return &resolved, nil
+}
+
+// ParseConfigYAML builds a Spec from a consensus-specs config.yaml, such as
+// the ones published in the eth-clients network repositories.
+// See ParseSpec for how genesis is used.
+func ParseConfigYAML(data []byte, genesis *apiv1.Genesis) (*Spec, error) {
+	config, err := ParseConfigYAMLValues(data)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSpec(config, genesis)
+}
+
+// ParseConfigYAMLValues returns the values of a consensus-specs config.yaml
+// as strings, like the spec configuration served by the Beacon API.
+func ParseConfigYAMLValues(data []byte) (map[string]interface{}, error) {
+	var nodes map[string]yaml.Node
+	if err := yaml.Unmarshal(data, &nodes); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	// Keep the literal text of scalars, since YAML would otherwise
+	// resolve fork versions such as 0x00000000 as integers.
+	// Non-scalar values (such as BLOB_SCHEDULE) are not part of Spec.
+	config := make(map[string]interface{}, len(nodes))
+	for key, node := range nodes {
+		if node.Kind == yaml.ScalarNode {
+			config[key] = node.Value
+		}
+	}
+	return config, nil
+}
+
+// ReadConfigYAML reads the config.yaml at the given path and
+// builds a Spec from it. See ParseConfigYAML.
+func ReadConfigYAML(path string, genesis *apiv1.Genesis) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfigYAML(data, genesis)
+}
+
+// specParser reads typed values from a spec configuration,
+// retaining the first error encountered.
+type specParser struct {
+	config map[string]interface{}
+	preset map[string]interface{}
+	err    error
+}
+
+func (p *specParser) value(key string) (interface{}, bool) {
+	if p.err != nil {
+		return nil, false
+	}
+	v, ok := p.config[key]
+	if !ok {
+		v, ok = p.preset[key]
+	}
+	if !ok {
+		v, ok = specConstants[key]
+	}
+	if !ok {
+		p.err = &SpecKeyError{Key: key, Err: ErrSpecKeyMissing}
+		return nil, false
+	}
+	return v, true
+}
+
+func (p *specParser) malformed(key string, value interface{}) {
+	p.err = &SpecKeyError{Key: key, Value: value, Err: ErrSpecKeyMalformed}
+}
+
+func (p *specParser) optionalString(key string) string {
+	if s, ok := p.config[key].(string); ok {
+		return s
+	}
+	return ""
+}
+
+func (p *specParser) uint64(key string) uint64 {
+	v, ok := p.value(key)
+	if !ok {
+		return 0
+	}
+	switch v := v.(type) {
+	case uint64:
+		return v
+	case int:
+		if v >= 0 {
+			return uint64(v)
+		}
+	case float64:
+		if v >= 0 && v == math.Trunc(v) {
+			return uint64(v)
+		}
+	case string:
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err == nil {
+			return n
+		}
+	}
+	p.malformed(key, v)
+	return 0
+}
+
+func (p *specParser) epoch(key string) phase0.Epoch {
+	return phase0.Epoch(p.uint64(key))
+}
+
+func (p *specParser) gwei(key string) phase0.Gwei {
+	return phase0.Gwei(p.uint64(key))
+}
+
+func (p *specParser) seconds(key string) uint64 {
+	v, ok := p.value(key)
+	if !ok {
+		return 0
+	}
+	if d, ok := v.(time.Duration); ok {
+		if d < 0 || d%time.Second != 0 {
+			p.malformed(key, v)
+			return 0
+		}
+		return uint64(d / time.Second)
+	}
+	return p.uint64(key)
+}
+
+func (p *specParser) bytes4(key string) [4]byte {
+	var b [4]byte
+	v, ok := p.value(key)
+	if !ok {
+		return b
+	}
+	switch v := v.(type) {
+	case phase0.Version:
+		return v
+	case phase0.DomainType:
+		return v
+	case []byte:
+		if len(v) == len(b) {
+			copy(b[:], v)
+			return b
+		}
+	case string:
+		decoded, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+		if err == nil && len(decoded) == len(b) {
+			copy(b[:], decoded)
+			return b
+		}
+	}
+	p.malformed(key, v)
+	return b
+}
+
+func (p *specParser) version(key string) phase0.Version {
+	return phase0.Version(p.bytes4(key))
+}
+
+func (p *specParser) domainType(key string) phase0.DomainType {
+	return phase0.DomainType(p.bytes4(key))
+}
@@ -0,0 +1,240 @@
+package beacon
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+// mainnetSpecConfig returns a subset of the /eth/v1/config/spec response of a mainnet node.
+func mainnetSpecConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"CONFIG_NAME":                               "mainnet",
+		"GENESIS_FORK_VERSION":                      "0x00000000",
+		"SLOTS_PER_EPOCH":                           "32",
+		"SECONDS_PER_SLOT":                          "12",
+		"MAX_COMMITTEES_PER_SLOT":                   "64",
+		"TARGET_COMMITTEE_SIZE":                     "128",
+		"TARGET_AGGREGATORS_PER_COMMITTEE":          "16",
+		"SHUFFLE_ROUND_COUNT":                       "90",
+		"MAX_EFFECTIVE_BALANCE":                     "32000000000",
+		"MAX_EFFECTIVE_BALANCE_ELECTRA":             "2048000000000",
+		"EFFECTIVE_BALANCE_INCREMENT":               "1000000000",
+		"MAX_SEED_LOOKAHEAD":                        "4",
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY":       "256",
+		"MAX_DEPOSITS":                              "16",
+		"MIN_PER_EPOCH_CHURN_LIMIT":                 "4",
+		"CHURN_LIMIT_QUOTIENT":                      "65536",
+		"MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT":      "8",
+		"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA":         "128000000000",
+		"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT": "256000000000",
+		"SYNC_COMMITTEE_SIZE":                       "512",
+		"EPOCHS_PER_SYNC_COMMITTEE_PERIOD":          "256",
+		"DOMAIN_BEACON_PROPOSER":                    "0x00000000",
+		"DOMAIN_BEACON_ATTESTER":                    "0x01000000",
+		"DOMAIN_RANDAO":                             "0x02000000",
+		"DOMAIN_DEPOSIT":                            "0x03000000",
+		"DOMAIN_VOLUNTARY_EXIT":                     "0x04000000",
+		"DOMAIN_SELECTION_PROOF":                    "0x05000000",
+		"DOMAIN_AGGREGATE_AND_PROOF":                "0x06000000",
+		"DOMAIN_SYNC_COMMITTEE":                     "0x07000000",
+		"DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF":     "0x08000000",
+		"DOMAIN_CONTRIBUTION_AND_PROOF":             "0x09000000",
+		"ALTAIR_FORK_VERSION":                       "0x01000000",
+		"ALTAIR_FORK_EPOCH":                         "74240",
+		"BELLATRIX_FORK_VERSION":                    "0x02000000",
+		"BELLATRIX_FORK_EPOCH":                      "144896",
+		"CAPELLA_FORK_VERSION":                      "0x03000000",
+		"CAPELLA_FORK_EPOCH":                        "194048",
+		"DENEB_FORK_VERSION":                        "0x04000000",
+		"DENEB_FORK_EPOCH":                          "269568",
+		"ELECTRA_FORK_VERSION":                      "0x05000000",
+		"ELECTRA_FORK_EPOCH":                        "364032",
+		"FULU_FORK_VERSION":                         "0x06000000",
+		"FULU_FORK_EPOCH":                           "411392",
+	}
+}
+
+func mainnetGenesis() *apiv1.Genesis {
+	return &apiv1.Genesis{
+		GenesisTime:           Mainnet.GenesisTime,
+		GenesisValidatorsRoot: Mainnet.GenesisValidatorsRoot,
+		GenesisForkVersion:    Mainnet.GenesisForkVersion,
+	}
+}
+
+type testSpecProvider struct {
+	config  map[string]interface{}
+	genesis *apiv1.Genesis
+}
+
+func (p *testSpecProvider) Spec(context.Context, *api.SpecOpts) (*api.Response[map[string]interface{}], error) {
+	return &api.Response[map[string]interface{}]{Data: p.config}, nil
+}
+
+func (p *testSpecProvider) Genesis(context.Context, *api.GenesisOpts) (*api.Response[*apiv1.Genesis], error) {
+	return &api.Response[*apiv1.Genesis]{Data: p.genesis}, nil
+}
+
+func TestFetchSpec(t *testing.T) {
+	spec, err := FetchSpec(context.Background(), &testSpecProvider{
+		config:  mainnetSpecConfig(),
+		genesis: mainnetGenesis(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, Mainnet, spec)
+}
+
+func TestParseSpecTypedValues(t *testing.T) {
+	// Values as parsed by go-eth2-client's HTTP service.
+	config := mainnetSpecConfig()
+	config["SECONDS_PER_SLOT"] = 12 * time.Second
+	config["SLOTS_PER_EPOCH"] = uint64(32)
+	config["DOMAIN_BEACON_ATTESTER"] = phase0.DomainType{1, 0, 0, 0}
+	config["ALTAIR_FORK_EPOCH"] = uint64(74240)
+
+	spec, err := ParseSpec(config, mainnetGenesis())
+	require.NoError(t, err)
+	require.Equal(t, Mainnet, spec)
+}
+
+func TestParseSpecErrors(t *testing.T) {
+	tests := []struct {
+		key      string
+		value    interface{}
+		expected error
+	}{
+		{"SLOTS_PER_EPOCH", nil, ErrSpecKeyMissing},
+		{"SLOTS_PER_EPOCH", "thirty-two", ErrSpecKeyMalformed},
+		{"SECONDS_PER_SLOT", 1500 * time.Millisecond, ErrSpecKeyMalformed},
+		{"DOMAIN_RANDAO", "0x0200", ErrSpecKeyMalformed},
+		{"BELLATRIX_FORK_EPOCH", -1, ErrSpecKeyMalformed},
+	}
+	for _, test := range tests {
+		config := mainnetSpecConfig()
+		if test.value == nil {
+			delete(config, test.key)
+		} else {
+			config[test.key] = test.value
+		}
+
+		_, err := ParseSpec(config, mainnetGenesis())
+		require.ErrorIs(t, err, test.expected, test.key)
+
+		var keyErr *SpecKeyError
+		require.True(t, errors.As(err, &keyErr), test.key)
+		require.Equal(t, test.key, keyErr.Key)
+	}
+}
+
+// devnetGenesis returns the genesis of testdata/config/devnet-minimal.yaml.
+func devnetGenesis() *apiv1.Genesis {
+	return &apiv1.Genesis{
+		GenesisTime:           time.Unix(1760000060, 0),
+		GenesisValidatorsRoot: phase0.Root{0x01},
+	}
+}
+
+func TestParseConfigYAML(t *testing.T) {
+	// The genesis of registered networks is taken from their Spec.
+	spec, err := ReadConfigYAML("testdata/config/hoodi.yaml", nil)
+	require.NoError(t, err)
+	require.Equal(t, Hoodi, spec)
+
+	// The genesis response takes precedence.
+	genesisTime := time.Unix(1742213401, 0)
+	spec, err = ReadConfigYAML("testdata/config/hoodi.yaml", &apiv1.Genesis{GenesisTime: genesisTime})
+	require.NoError(t, err)
+	require.Equal(t, genesisTime, spec.GenesisTime)
+	require.Equal(t, Hoodi.GenesisValidatorsRoot, spec.GenesisValidatorsRoot)
+	require.Equal(t, Hoodi.GenesisForkVersion, spec.GenesisForkVersion)
+
+	// The genesis of other networks is required.
+	_, err = ReadConfigYAML("testdata/config/devnet-minimal.yaml", nil)
+	require.ErrorIs(t, err, ErrUnknownGenesis)
+	_, err = ReadConfigYAML("testdata/config/devnet-minimal.yaml", &apiv1.Genesis{GenesisTime: time.Unix(1760000060, 0)})
+	require.ErrorIs(t, err, ErrUnknownGenesis)
+
+	spec, err = ReadConfigYAML("testdata/config/devnet-minimal.yaml", devnetGenesis())
+	require.NoError(t, err)
+	require.Equal(t, Network("devnet"), spec.Network)
+	require.Equal(t, time.Unix(1760000060, 0), spec.GenesisTime)
+	require.Equal(t, phase0.Root{0x01}, spec.GenesisValidatorsRoot)
+	require.Equal(t, phase0.Slot(8), spec.SlotsPerEpoch)
+	require.Equal(t, 6*time.Second, spec.SlotDuration())
+	require.Equal(t, uint64(32), spec.SyncCommitteeSize)
+	require.Equal(t, phase0.Version{0x60, 0x00, 0x00, 0x38}, spec.ElectraForkVersion)
+	require.Equal(t, spec.FarFutureEpoch, spec.FuluForkEpoch)
+}
+
+func TestParseConfigYAMLInvalid(t *testing.T) {
+	for _, path := range []string{
+		"testdata/config/invalid-fork-order.yaml",
+		"testdata/config/invalid-sync-committee.yaml",
+	} {
+		_, err := ReadConfigYAML(path, devnetGenesis())
+		require.ErrorIs(t, err, ErrInvalidSpec, path)
+	}
+
+	_, err := ParseConfigYAML([]byte("PRESET_BASE: 'gnosis'"), nil)
+	require.ErrorIs(t, err, ErrSpecKeyMalformed)
+}
+
+func TestRegisterNetwork(t *testing.T) {
+	spec, err := ReadConfigYAML("testdata/config/devnet-minimal.yaml", devnetGenesis())
+	require.NoError(t, err)
+
+	require.NoError(t, RegisterNetwork("test-devnet", spec))
+	defer func() {
+		networksMu.Lock()
+		defer networksMu.Unlock()
+		delete(networks, "test-devnet")
+	}()
+	registered, ok := LookupNetwork("test-devnet")
+	require.True(t, ok)
+	require.Same(t, spec, registered)
+	require.Contains(t, KnownNetworks(), Network("test-devnet"))
+
+	// Networks can be registered while specs are parsed.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := ReadConfigYAML("testdata/config/hoodi.yaml", nil)
+		require.NoError(t, err)
+	}()
+	concurrent := *spec
+	require.NoError(t, RegisterNetwork("test-concurrent", &concurrent))
+	defer func() {
+		networksMu.Lock()
+		defer networksMu.Unlock()
+		delete(networks, "test-concurrent")
+	}()
+	wg.Wait()
+	require.Equal(t, Network("test-devnet"), spec.Network)
+
+	require.ErrorIs(t, RegisterNetwork("test-devnet", spec), ErrNetworkExists)
+	require.ErrorIs(t, RegisterNetwork(Mainnet.Network, spec), ErrNetworkExists)
+
+	invalid := *spec
+	invalid.SyncCommitteeSubnetCount = 3
+	require.ErrorIs(t, RegisterNetwork("test-invalid", &invalid), ErrInvalidSpec)
+	invalid = *spec
+	invalid.GenesisSlot = spec.SlotsPerEpoch + 1
+	require.ErrorIs(t, RegisterNetwork("test-invalid", &invalid), ErrInvalidSpec)
+	invalid = *spec
+	invalid.MinPerEpochChurnLimit = 0
+	require.ErrorIs(t, RegisterNetwork("test-invalid", &invalid), ErrInvalidSpec)
+	invalid = *spec
+	invalid.MinPerEpochChurnLimitElectra = spec.EffectiveBalanceIncrement - 1
+	require.ErrorIs(t, RegisterNetwork("test-invalid", &invalid), ErrInvalidSpec)
+	_, ok = LookupNetwork("test-invalid")
+	require.False(t, ok)
+}
@@ -0,0 +1,60 @@
+package beacon
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// SpecDiff is a field which differs between two Specs.
+type SpecDiff struct {
+	Field    string
+	Expected interface{}
+	Actual   interface{}
+}
+
+func (d SpecDiff) String() string {
+	return fmt.Sprintf("%s: expected %s, got %s", d.Field, formatSpecValue(d.Expected), formatSpecValue(d.Actual))
+}
+
+func formatSpecValue(v interface{}) string {
+	if t, ok := v.(time.Time); ok {
+		return fmt.Sprintf("%s (%d)", t.UTC().Format(time.RFC3339), t.Unix())
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8 {
+		return fmt.Sprintf("%#x", v)
+	}
+	return fmt.Sprint(v)
+}
+
+// Diff returns the fields of actual which differ from s, such as the genesis,
+// slot timing, domain types and fork schedule. Network is not compared, since
+// nodes may name the same network differently.
+func (s *Spec) Diff(actual *Spec) []SpecDiff {
+	var (
+		diffs         []SpecDiff
+		expectedValue = reflect.ValueOf(s).Elem()
+		actualValue   = reflect.ValueOf(actual).Elem()
+	)
+	for i := 0; i < expectedValue.NumField(); i++ {
+		field := expectedValue.Type().Field(i)
+		if !field.IsExported() || field.Name == "Network" {
+			continue
+		}
+		expected, actual := expectedValue.Field(i).Interface(), actualValue.Field(i).Interface()
+		if t, ok := expected.(time.Time); ok {
+			if t.Equal(actual.(time.Time)) {
+				continue
+			}
+		} else if reflect.DeepEqual(expected, actual) {
+			continue
+		}
+		diffs = append(diffs, SpecDiff{
+			Field:    field.Name,
+			Expected: expected,
+			Actual:   actual,
+		})
+	}
+	return diffs
+}
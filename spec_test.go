@@ -5,6 +5,7 @@ import (
 	"crypto/sha1"
 	"encoding/hex"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -22,6 +23,33 @@ func TestSpecTimeAtSlot(t *testing.T) {
 		Add(32*time.Duration(spec.SecondsPerSlot)*time.Second))
 }
 
+func TestSpecGenesisSlot(t *testing.T) {
+	spec := &Spec{
+		GenesisTime:    time.Unix(1606824000, 0),
+		GenesisSlot:    64,
+		SlotsPerEpoch:  32,
+		SecondsPerSlot: 12,
+	}
+	require.Equal(t, spec.GenesisTime, spec.TimeAtSlot(64))
+	require.Equal(t, spec.GenesisTime.Add(-12*time.Second), spec.TimeAtSlot(63))
+	require.Equal(t, phase0.Slot(65), spec.SlotAtTime(spec.GenesisTime.Add(12*time.Second)))
+	require.Equal(t, phase0.Epoch(2), spec.GenesisEpoch())
+	require.Equal(t, spec.GenesisSlot, spec.StartSlot(spec.GenesisEpoch()))
+
+	// Before genesis.
+	require.Equal(t, phase0.Slot(64), spec.SlotAtTime(spec.GenesisTime.Add(-time.Hour)))
+
+	// The Clock agrees with the Spec.
+	clock := spec.Clock()
+	for _, slot := range []phase0.Slot{64, 65, 100} {
+		require.Equal(t, spec.TimeAtSlot(slot), clock.AtSlot(slot).Time())
+		require.Equal(t, slot, clock.AtTime(spec.TimeAtSlot(slot)).Slot())
+		require.Equal(t, spec.EpochFromSlot(slot), clock.AtSlot(slot).Epoch())
+		require.Equal(t, spec.StartSlot(spec.EpochFromSlot(slot)), clock.AtSlot(slot).StartSlot())
+	}
+	require.Equal(t, phase0.Slot(64), clock.AtTime(spec.GenesisTime.Add(-time.Hour)).Slot())
+}
+
 // TestSpecAttestationSubnetID reproduces the output of the Python script described in
 // attestationSubnetExpectedHash.
 func TestSpecAttestationSubnetID(t *testing.T) {
@@ -65,3 +93,50 @@ func TestSpecAttestationSubnetID(t *testing.T) {
 //   import hashlib
 //   hashlib.sha1(output.encode('utf-8')).hexdigest()
 const attestationSubnetExpectedHash = "95f76cfe1f07c26d2d8d775cab47c47664679637"
+
+func TestSpecSyncCommitteePeriod(t *testing.T) {
+	require.Equal(t, uint64(0), Mainnet.SyncCommitteePeriod(255))
+	require.Equal(t, uint64(1), Mainnet.SyncCommitteePeriod(256))
+	require.Equal(t, phase0.Epoch(512), Mainnet.SyncCommitteePeriodStartEpoch(2))
+	require.Equal(t, phase0.Epoch(767), Mainnet.SyncCommitteePeriodEndEpoch(2))
+
+	lastSlot := Mainnet.EndSlot(Mainnet.SyncCommitteePeriodEndEpoch(2))
+	require.True(t, Mainnet.IsLastSlotOfSyncCommitteePeriod(lastSlot))
+	require.False(t, Mainnet.IsLastSlotOfSyncCommitteePeriod(lastSlot-1))
+	require.False(t, Mainnet.IsLastSlotOfSyncCommitteePeriod(lastSlot+1))
+
+	c := Mainnet.Clock()
+	require.Equal(t, uint64(2), c.AtSlot(lastSlot).SyncCommitteePeriod())
+	require.Equal(t, uint64(3), c.AtSlot(lastSlot+1).SyncCommitteePeriod())
+	require.Equal(t, lastSlot+1, c.AtSyncCommitteePeriod(3).Slot())
+}
+
+func TestSpecClockParams(t *testing.T) {
+	params := Mainnet.ClockParams()
+	require.Equal(t, Mainnet.AttestationPropagationSlotRange, params.AttestationPropagationSlotRange)
+	require.Equal(t, Mainnet.TimeAtSlot(43), Mainnet.Clock().AtSlot(10).AttestationPropagationDeadline())
+}
+
+func TestSpecDiff(t *testing.T) {
+	require.Empty(t, Hoodi.Diff(Hoodi))
+
+	actual := *Hoodi
+	actual.Network = "hoodi-node"
+	actual.GenesisTime = Hoodi.GenesisTime.UTC()
+	require.Empty(t, Hoodi.Diff(&actual))
+
+	diffs := Hoodi.Diff(Holesky)
+	fields := make([]string, 0, len(diffs))
+	for _, diff := range diffs {
+		fields = append(fields, diff.Field)
+	}
+	require.Contains(t, fields, "GenesisTime")
+	require.Contains(t, fields, "GenesisForkVersion")
+	require.Contains(t, fields, "ElectraForkEpoch")
+	require.NotContains(t, fields, "SecondsPerSlot")
+	require.NotContains(t, fields, "Network")
+	require.Equal(t,
+		"GenesisForkVersion: expected 0x10000910, got 0x01017000",
+		diffs[slices.IndexFunc(diffs, func(d SpecDiff) bool { return d.Field == "GenesisForkVersion" })].String(),
+	)
+}